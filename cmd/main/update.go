@@ -63,10 +63,15 @@ func (m MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					selectedDate = helpers.ToMidnight(time.Now())
 				}
 
+				var selectedUntil time.Time
+				if welcomeUntil := m.welcomeModel.GetSelectedUntil(); welcomeUntil != nil {
+					selectedUntil = *welcomeUntil
+				}
+
 				hasGit := git.ShouldEnableDevMode()
 				hasJira := jira.ShouldEnableJiraMode()
 
-				m.statsModel = statistics.InitialModel("", selectedDate, hasGit, hasJira)
+				m.statsModel = statistics.InitialModel("", selectedDate, selectedUntil, hasGit, hasJira)
 
 				initCmd := m.statsModel.Init()
 
@@ -104,6 +109,7 @@ func (m MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case "start":
 				return m, nil
 			case "reset":
+				m.statsModel.CancelLoads()
 				m.state = WelcomeState
 				if m.width > 0 && m.height > 0 {
 					windowSizeMsg := tea.WindowSizeMsg{Width: m.width, Height: m.height}
@@ -2,7 +2,10 @@ package commands
 
 import (
 	"fmt"
+	"path"
+	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -120,6 +123,17 @@ func (r *Registry) ValidateCommand(input string) (Command, error) {
 				}, nil
 			}
 
+			if parts[2] == "token" {
+				if len(parts) < 5 {
+					return Command{}, fmt.Errorf("git repo token command requires a repository URL and a token. Usage: git repo token <url> <token>")
+				}
+				return Command{
+					Name:        fmt.Sprintf("git repo token %s %s", parts[3], parts[4]),
+					Description: "Set an access token for a specific repository",
+					Action:      "git_set_repo_token",
+				}, nil
+			}
+
 			value := strings.Join(parts[2:], " ")
 			return Command{
 				Name:        fmt.Sprintf("git repo %s", value),
@@ -133,6 +147,16 @@ func (r *Registry) ValidateCommand(input string) (Command, error) {
 				return Command{}, fmt.Errorf("git token command requires a value. Usage: git token <github-api-token>")
 			}
 
+			tokenProviders := map[string]bool{"github": true, "gitlab": true, "bitbucket": true, "bitbucket-server": true}
+			if len(parts) >= 4 && tokenProviders[parts[2]] {
+				value := strings.Join(parts[3:], " ")
+				return Command{
+					Name:        fmt.Sprintf("git token %s %s", parts[2], value),
+					Description: "Set API token for a Git provider",
+					Action:      "git_set_provider_token",
+				}, nil
+			}
+
 			value := strings.Join(parts[2:], " ")
 			return Command{
 				Name:        fmt.Sprintf("git token %s", value),
@@ -141,6 +165,196 @@ func (r *Registry) ValidateCommand(input string) (Command, error) {
 			}, nil
 		}
 
+		if subCommand == "pages" {
+			if len(parts) < 3 {
+				return Command{}, fmt.Errorf("git pages command requires a number. Usage: git pages <max-pages>")
+			}
+			if n, err := strconv.Atoi(parts[2]); err != nil || n < 1 {
+				return Command{}, fmt.Errorf("invalid page cap: %s. Use a positive number (e.g., git pages 20)", parts[2])
+			}
+
+			return Command{
+				Name:        fmt.Sprintf("git pages %s", parts[2]),
+				Description: "Set the maximum number of API pages fetched per branch",
+				Action:      "git_set_max_pages",
+			}, nil
+		}
+
+		if subCommand == "concurrency" {
+			if len(parts) < 3 {
+				return Command{}, fmt.Errorf("git concurrency command requires a number. Usage: git concurrency <n>")
+			}
+			if n, err := strconv.Atoi(parts[2]); err != nil || n < 1 {
+				return Command{}, fmt.Errorf("invalid concurrency limit: %s. Use a positive number (e.g., git concurrency 4)", parts[2])
+			}
+
+			return Command{
+				Name:        fmt.Sprintf("git concurrency %s", parts[2]),
+				Description: "Set how many repositories and branches are fetched in parallel",
+				Action:      "git_set_concurrency",
+			}, nil
+		}
+
+		if subCommand == "cache" {
+			if len(parts) < 3 || parts[2] != "clear" {
+				return Command{}, fmt.Errorf("git cache command requires 'clear'. Usage: git cache clear")
+			}
+
+			return Command{
+				Name:        "git cache clear",
+				Description: "Clear the cached GitHub API responses",
+				Action:      "git_clear_cache",
+			}, nil
+		}
+
+		if subCommand == "stats" {
+			if len(parts) < 3 || (parts[2] != "on" && parts[2] != "off") {
+				return Command{}, fmt.Errorf("git stats command requires 'on' or 'off'. Usage: git stats <on|off>")
+			}
+
+			if parts[2] == "on" {
+				return Command{
+					Name:        "git stats on",
+					Description: "Show lines added/removed and files changed per commit",
+					Action:      "git_stats_on",
+				}, nil
+			}
+			return Command{
+				Name:        "git stats off",
+				Description: "Hide per-commit diff statistics",
+				Action:      "git_stats_off",
+			}, nil
+		}
+
+		if subCommand == "coauthors" || subCommand == "co-authors" {
+			if len(parts) < 3 || (parts[2] != "on" && parts[2] != "off") {
+				return Command{}, fmt.Errorf("git coauthors command requires 'on' or 'off'. Usage: git coauthors <on|off>")
+			}
+
+			if parts[2] == "on" {
+				return Command{
+					Name:        "git coauthors on",
+					Description: "Match the author filter against co-authors too",
+					Action:      "git_coauthors_on",
+				}, nil
+			}
+			return Command{
+				Name:        "git coauthors off",
+				Description: "Match the author filter against the commit author only",
+				Action:      "git_coauthors_off",
+			}, nil
+		}
+
+		if subCommand == "alias" || subCommand == "aliases" {
+			if len(parts) == 2 || parts[2] == "list" || parts[2] == "ls" {
+				return Command{
+					Name:        "git alias list",
+					Description: "List author aliases",
+					Action:      "git_list_aliases",
+				}, nil
+			}
+
+			if parts[2] == "remove" || parts[2] == "rm" {
+				if len(parts) < 4 {
+					return Command{}, fmt.Errorf("git alias remove command requires an alias. Usage: git alias remove <alias>")
+				}
+				return Command{
+					Name:        "git alias remove " + strings.Join(parts[3:], " "),
+					Description: "Remove an author alias",
+					Action:      "git_remove_alias",
+				}, nil
+			}
+
+			alias, canonical := "", ""
+			rest := strings.Join(parts[2:], " ")
+			if idx := strings.Index(rest, "="); idx >= 0 {
+				alias = strings.TrimSpace(rest[:idx])
+				canonical = strings.TrimSpace(rest[idx+1:])
+			} else if len(parts) > 3 {
+				alias = parts[2]
+				canonical = strings.Join(parts[3:], " ")
+			}
+			if alias == "" || canonical == "" {
+				return Command{}, fmt.Errorf("git alias command requires an alias and a canonical name. Usage: git alias <name-or-email> = <canonical name>")
+			}
+
+			return Command{
+				Name:        fmt.Sprintf("git alias %s = %s", alias, canonical),
+				Description: "Map an author name or email to a canonical identity",
+				Action:      "git_set_alias",
+			}, nil
+		}
+
+		if subCommand == "mirror" || subCommand == "mirrors" {
+			if len(parts) < 3 || parts[2] != "clear" {
+				return Command{}, fmt.Errorf("git mirror command requires 'clear'. Usage: git mirror clear")
+			}
+
+			return Command{
+				Name:        "git mirror clear",
+				Description: "Delete local mirrors of remote repositories",
+				Action:      "git_clear_mirrors",
+			}, nil
+		}
+
+		if subCommand == "host" || subCommand == "hosts" {
+			if len(parts) == 2 || parts[2] == "list" || parts[2] == "ls" {
+				return Command{
+					Name:        "git host list",
+					Description: "List registered Git hosts",
+					Action:      "git_list_hosts",
+				}, nil
+			}
+
+			if parts[2] == "remove" || parts[2] == "rm" {
+				if len(parts) < 4 {
+					return Command{}, fmt.Errorf("git host remove command requires a host name. Usage: git host remove <hostname>")
+				}
+				return Command{
+					Name:        fmt.Sprintf("git host remove %s", parts[3]),
+					Description: "Remove a registered Git host",
+					Action:      "git_remove_host",
+				}, nil
+			}
+
+			if parts[2] == "token" {
+				if len(parts) < 5 {
+					return Command{}, fmt.Errorf("git host token command requires a host name and a token. Usage: git host token <hostname> <token>")
+				}
+				return Command{
+					Name:        fmt.Sprintf("git host token %s %s", parts[3], parts[4]),
+					Description: "Set the API token for a registered Git host",
+					Action:      "git_set_host_token",
+				}, nil
+			}
+
+			if len(parts) < 4 {
+				return Command{}, fmt.Errorf("git host command requires a host name and a provider. Usage: git host <hostname> <github|gitlab|gitea|forgejo|bitbucket-server|mirror> [api-url]")
+			}
+
+			name := fmt.Sprintf("git host %s %s", parts[2], parts[3])
+			if len(parts) > 4 {
+				name += " " + parts[4]
+			}
+			return Command{
+				Name:        name,
+				Description: "Register a Git host and its provider",
+				Action:      "git_set_host",
+			}, nil
+		}
+
+		if subCommand == "provider" {
+			if len(parts) < 4 {
+				return Command{}, fmt.Errorf("git provider command requires a repository URL and a provider. Usage: git provider <url> <github|gitlab|bitbucket|bitbucket-server|gitea|mirror>")
+			}
+
+			return Command{
+				Name:        fmt.Sprintf("git provider %s %s", parts[2], parts[3]),
+				Description: "Set the hosting provider for a repository",
+				Action:      "git_set_provider",
+			}, nil
+		}
+
 		if subCommand == "author" || subCommand == "a" {
 			authorNames := ""
 			if len(parts) > 2 {
@@ -178,6 +392,19 @@ func (r *Registry) ValidateCommand(input string) (Command, error) {
 				}, nil
 			}
 
+			for _, branch := range GetBranchNamesFromCommand("git b " + branchNames) {
+				pattern := strings.TrimPrefix(branch, "!")
+				if expr, ok := strings.CutPrefix(pattern, "re:"); ok {
+					if _, err := regexp.Compile(expr); err != nil {
+						return Command{}, fmt.Errorf("invalid branch regex %q: %w", expr, err)
+					}
+				} else if strings.ContainsAny(pattern, "*?[") {
+					if _, err := path.Match(pattern, ""); err != nil {
+						return Command{}, fmt.Errorf("invalid branch pattern %q: %w", pattern, err)
+					}
+				}
+			}
+
 			return Command{
 				Name:        "git b " + branchNames,
 				Description: "Filter commits by branch name(s)",
@@ -185,7 +412,101 @@ func (r *Registry) ValidateCommand(input string) (Command, error) {
 			}, nil
 		}
 
-		return Command{}, fmt.Errorf("unknown git subcommand: %s\nAvailable: status, repo, token, author, branch\nFor Git help, use: void help git", subCommand)
+		if subCommand == "type" || subCommand == "types" {
+			types := ""
+			if len(parts) > 2 {
+				types = strings.Join(parts[2:], " ")
+				types = strings.TrimSpace(types)
+			}
+
+			if types == "" {
+				return Command{
+					Name:        "git type",
+					Description: "Clear commit type filter and show all commits",
+					Action:      "clear_type_filter",
+				}, nil
+			}
+
+			return Command{
+				Name:        "git type " + types,
+				Description: "Filter commits by Conventional Commits type",
+				Action:      "filter_by_type",
+			}, nil
+		}
+
+		if subCommand == "message" || subCommand == "m" {
+			pattern := ""
+			if len(parts) > 2 {
+				pattern = strings.Join(parts[2:], " ")
+				pattern = strings.TrimSpace(pattern)
+			}
+
+			if pattern == "" {
+				return Command{
+					Name:        "git m",
+					Description: "Clear message filter and show all commits",
+					Action:      "clear_message_filter",
+				}, nil
+			}
+
+			search, _ := GetMessageFilterFromCommand("git m " + pattern)
+			if search == "" {
+				return Command{}, fmt.Errorf("git m command requires search text. Usage: git m [--case] <text|re:regex>")
+			}
+			if expr, ok := strings.CutPrefix(search, "re:"); ok {
+				if _, err := regexp.Compile(expr); err != nil {
+					return Command{}, fmt.Errorf("invalid message regex %q: %w", expr, err)
+				}
+			}
+
+			return Command{
+				Name:        "git m " + pattern,
+				Description: "Filter commits by message text",
+				Action:      "filter_by_message",
+			}, nil
+		}
+
+		if subCommand == "path" || subCommand == "p" {
+			paths := ""
+			if len(parts) > 2 {
+				paths = strings.Join(parts[2:], " ")
+				paths = strings.TrimSpace(paths)
+			}
+
+			if paths == "" {
+				return Command{
+					Name:        "git p",
+					Description: "Clear path filter and show all commits",
+					Action:      "clear_path_filter",
+				}, nil
+			}
+
+			for _, pattern := range GetPathsFromCommand("git p " + paths) {
+				if _, err := path.Match(pattern, ""); err != nil {
+					return Command{}, fmt.Errorf("invalid path pattern %q: %w", pattern, err)
+				}
+			}
+
+			return Command{
+				Name:        "git p " + paths,
+				Description: "Filter commits by the paths they touch",
+				Action:      "filter_by_path",
+			}, nil
+		}
+
+		if subCommand == "merges" || subCommand == "merge" {
+			if len(parts) < 3 || (parts[2] != "on" && parts[2] != "off" && parts[2] != "only") {
+				return Command{}, fmt.Errorf("git merges command requires 'on', 'off' or 'only'. Usage: git merges <on|off|only>")
+			}
+
+			return Command{
+				Name:        "git merges " + parts[2],
+				Description: "Include, exclude or isolate merge commits",
+				Action:      "filter_by_merges",
+			}, nil
+		}
+
+		return Command{}, fmt.Errorf("unknown git subcommand: %s\nAvailable: status, repo, token, host, provider, pages, concurrency, cache, mirror, stats, coauthors, alias, author, branch, path, message, type, merges\nFor Git help, use: void help git", subCommand)
 	}
 
 	if strings.HasPrefix(input, "git a ") || input == "git a" {
@@ -342,12 +663,38 @@ func (r *Registry) ValidateCommand(input string) (Command, error) {
 			}, nil
 		}
 
+		if subCommand == "range" || subCommand == "r" {
+			if len(parts) < 4 {
+				return Command{}, fmt.Errorf("void range command requires two dates. Usage: void range <YYYY-MM-DD> <YYYY-MM-DD>")
+			}
+
+			fromDate, err := time.Parse("2006-01-02", parts[2])
+			if err != nil {
+				return Command{}, fmt.Errorf("invalid start date format. Use YYYY-MM-DD format (e.g., void range 2025-05-01 2025-05-31)")
+			}
+
+			toDate, err := time.Parse("2006-01-02", parts[3])
+			if err != nil {
+				return Command{}, fmt.Errorf("invalid end date format. Use YYYY-MM-DD format (e.g., void range 2025-05-01 2025-05-31)")
+			}
+
+			if toDate.Before(fromDate) {
+				return Command{}, fmt.Errorf("end date %s is before start date %s", parts[3], parts[2])
+			}
+
+			return Command{
+				Name:        fmt.Sprintf("void range %s %s", parts[2], parts[3]),
+				Description: "Set analysis date range",
+				Action:      "void_set_range",
+			}, nil
+		}
+
 		cmd, exists := r.commands[input]
 		if exists {
 			return cmd, nil
 		}
 
-		return Command{}, fmt.Errorf("unknown void subcommand: %s\nAvailable: help, start, reset, quit, set-date, help git, help jira", subCommand)
+		return Command{}, fmt.Errorf("unknown void subcommand: %s\nAvailable: help, start, reset, quit, set-date, range, help git, help jira", subCommand)
 	}
 
 	cleanName := strings.TrimPrefix(input, "./")
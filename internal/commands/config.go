@@ -26,7 +26,7 @@ func GetGitConfigValue(commandName string) (string, string) {
 			return "", ""
 		}
 
-		specialCommands := []string{"list", "ls", "clear", "reset"}
+		specialCommands := []string{"list", "ls", "clear", "reset", "token"}
 		for _, special := range specialCommands {
 			if strings.HasPrefix(value, special) {
 				return "", ""
@@ -37,6 +37,87 @@ func GetGitConfigValue(commandName string) (string, string) {
 	return key, value
 }
 
+func GetProviderTokenValue(commandName string) (string, string) {
+	parts := strings.Fields(commandName)
+	if len(parts) < 4 || parts[0] != "git" || parts[1] != "token" {
+		return "", ""
+	}
+
+	return parts[2], strings.Join(parts[3:], " ")
+}
+
+func GetRepoTokenValue(commandName string) (string, string) {
+	parts := strings.Fields(commandName)
+	if len(parts) != 5 || parts[0] != "git" || parts[1] != "repo" || parts[2] != "token" {
+		return "", ""
+	}
+
+	return parts[3], parts[4]
+}
+
+func GetRepoProviderValue(commandName string) (string, string) {
+	parts := strings.Fields(commandName)
+	if len(parts) != 4 || parts[0] != "git" || parts[1] != "provider" {
+		return "", ""
+	}
+
+	return parts[2], strings.ToLower(parts[3])
+}
+
+func GetHostValue(commandName string) (string, string, string) {
+	parts := strings.Fields(commandName)
+	if len(parts) < 4 || parts[0] != "git" || parts[1] != "host" {
+		return "", "", ""
+	}
+
+	apiURL := ""
+	if len(parts) > 4 {
+		apiURL = parts[4]
+	}
+
+	return parts[2], strings.ToLower(parts[3]), apiURL
+}
+
+func GetHostTokenValue(commandName string) (string, string) {
+	parts := strings.Fields(commandName)
+	if len(parts) != 5 || parts[0] != "git" || parts[1] != "host" || parts[2] != "token" {
+		return "", ""
+	}
+
+	return parts[3], parts[4]
+}
+
+func GetHostRemoveValue(commandName string) string {
+	parts := strings.Fields(commandName)
+	if len(parts) != 4 || parts[0] != "git" || parts[1] != "host" || parts[2] != "remove" {
+		return ""
+	}
+
+	return parts[3]
+}
+
+func GetAliasValue(commandName string) (string, string) {
+	if !strings.HasPrefix(commandName, "git alias ") {
+		return "", ""
+	}
+
+	rest := strings.TrimPrefix(commandName, "git alias ")
+	idx := strings.Index(rest, " = ")
+	if idx < 0 {
+		return "", ""
+	}
+
+	return strings.TrimSpace(rest[:idx]), strings.TrimSpace(rest[idx+3:])
+}
+
+func GetAliasRemoveValue(commandName string) string {
+	if !strings.HasPrefix(commandName, "git alias remove ") {
+		return ""
+	}
+
+	return strings.TrimSpace(strings.TrimPrefix(commandName, "git alias remove "))
+}
+
 func GetJiraConfigValue(commandName string) (string, string) {
 	if !strings.HasPrefix(commandName, "jira ") {
 		return "", ""
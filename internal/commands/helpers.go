@@ -14,6 +14,12 @@ func GetAuthorNamesFromCommand(commandName string) []string {
 	authorPart := strings.TrimPrefix(commandName, "git a ")
 	authorPart = strings.TrimSpace(authorPart)
 
+	exact := false
+	if rest, ok := strings.CutPrefix(authorPart, "--exact"); ok {
+		exact = true
+		authorPart = strings.TrimSpace(rest)
+	}
+
 	if authorPart == "" {
 		return nil
 	}
@@ -21,8 +27,11 @@ func GetAuthorNamesFromCommand(commandName string) []string {
 	authors := strings.Split(authorPart, ",")
 	var cleanAuthors []string
 	for _, author := range authors {
-		cleanAuthor := strings.TrimSpace(author)
-		if cleanAuthor != "" {
+		cleanAuthor := strings.Trim(strings.TrimSpace(author), `"'`)
+		if exact && !strings.HasPrefix(cleanAuthor, "=") {
+			cleanAuthor = "=" + cleanAuthor
+		}
+		if strings.TrimPrefix(cleanAuthor, "=") != "" {
 			cleanAuthors = append(cleanAuthors, cleanAuthor)
 		}
 	}
@@ -30,6 +39,14 @@ func GetAuthorNamesFromCommand(commandName string) []string {
 	return cleanAuthors
 }
 
+func GetMergeModeFromCommand(commandName string) string {
+	if !strings.HasPrefix(commandName, "git merges ") {
+		return ""
+	}
+
+	return strings.TrimSpace(strings.TrimPrefix(commandName, "git merges "))
+}
+
 func GetBranchNamesFromCommand(commandName string) []string {
 	if !strings.HasPrefix(commandName, "git b ") {
 		return nil
@@ -42,10 +59,10 @@ func GetBranchNamesFromCommand(commandName string) []string {
 		return nil
 	}
 
-	branches := strings.Split(branchPart, ",")
+	branches := splitBranchPatterns(branchPart)
 	var cleanBranches []string
 	for _, branch := range branches {
-		cleanBranch := strings.TrimSpace(branch)
+		cleanBranch := strings.Trim(strings.TrimSpace(branch), `"'`)
 		if cleanBranch != "" {
 			cleanBranches = append(cleanBranches, cleanBranch)
 		}
@@ -54,6 +71,109 @@ func GetBranchNamesFromCommand(commandName string) []string {
 	return cleanBranches
 }
 
+func splitBranchPatterns(branchPart string) []string {
+	var patterns []string
+	start, depth := 0, 0
+	escaped, inClass := false, false
+	for i, r := range branchPart {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == ',' && !((inClass || depth > 0) && isBranchRegex(branchPart[start:i])):
+			patterns = append(patterns, branchPart[start:i])
+			start, depth, inClass = i+1, 0, false
+		case inClass:
+			if r == ']' {
+				inClass = false
+			}
+		case r == '[':
+			inClass = true
+		case r == '(' || r == '{':
+			depth++
+		case (r == ')' || r == '}') && depth > 0:
+			depth--
+		}
+	}
+
+	return append(patterns, branchPart[start:])
+}
+
+func isBranchRegex(pattern string) bool {
+	pattern = strings.TrimLeft(strings.TrimSpace(pattern), `"'`)
+	return strings.HasPrefix(strings.TrimPrefix(pattern, "!"), "re:")
+}
+
+func GetMessageFilterFromCommand(commandName string) (string, bool) {
+	if !strings.HasPrefix(commandName, "git m ") {
+		return "", false
+	}
+
+	pattern := strings.TrimSpace(strings.TrimPrefix(commandName, "git m "))
+
+	caseSensitive := false
+	if rest, ok := strings.CutPrefix(pattern, "--case"); ok {
+		caseSensitive = true
+		pattern = strings.TrimSpace(rest)
+	}
+
+	if len(pattern) >= 2 && (pattern[0] == '"' || pattern[0] == '\'') && pattern[len(pattern)-1] == pattern[0] {
+		pattern = pattern[1 : len(pattern)-1]
+	}
+
+	return pattern, caseSensitive
+}
+
+func GetTypesFromCommand(commandName string) []string {
+	if !strings.HasPrefix(commandName, "git type ") {
+		return nil
+	}
+
+	typePart := strings.TrimPrefix(commandName, "git type ")
+	typePart = strings.TrimSpace(typePart)
+
+	if typePart == "" {
+		return nil
+	}
+
+	types := strings.Split(typePart, ",")
+	var cleanTypes []string
+	for _, commitType := range types {
+		cleanType := strings.ToLower(strings.TrimSpace(commitType))
+		if cleanType != "" {
+			cleanTypes = append(cleanTypes, cleanType)
+		}
+	}
+
+	return cleanTypes
+}
+
+func GetPathsFromCommand(commandName string) []string {
+	if !strings.HasPrefix(commandName, "git p ") {
+		return nil
+	}
+
+	pathPart := strings.TrimPrefix(commandName, "git p ")
+	pathPart = strings.TrimSpace(pathPart)
+
+	if pathPart == "" {
+		return nil
+	}
+
+	paths := strings.Split(pathPart, ",")
+	var cleanPaths []string
+	for _, path := range paths {
+		cleanPath := strings.Trim(strings.TrimSpace(path), `"'`)
+		cleanPath = strings.TrimPrefix(cleanPath, "./")
+		if cleanPath != "" {
+			cleanPaths = append(cleanPaths, cleanPath)
+		}
+	}
+
+	return cleanPaths
+}
+
 func GetDateFromCommand(commandName string) (time.Time, error) {
 	if !strings.HasPrefix(commandName, "void sd ") {
 		return time.Time{}, fmt.Errorf("not a void sd command")
@@ -73,3 +193,26 @@ func GetDateFromCommand(commandName string) (time.Time, error) {
 
 	return parsedDate, nil
 }
+
+func GetDateRangeFromCommand(commandName string) (time.Time, time.Time, error) {
+	if !strings.HasPrefix(commandName, "void range ") {
+		return time.Time{}, time.Time{}, fmt.Errorf("not a void range command")
+	}
+
+	parts := strings.Fields(strings.TrimPrefix(commandName, "void range "))
+	if len(parts) != 2 {
+		return time.Time{}, time.Time{}, fmt.Errorf("expected a start and an end date")
+	}
+
+	fromDate, err := time.Parse("2006-01-02", parts[0])
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid start date format: %w", err)
+	}
+
+	toDate, err := time.Parse("2006-01-02", parts[1])
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid end date format: %w", err)
+	}
+
+	return fromDate, toDate, nil
+}
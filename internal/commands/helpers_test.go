@@ -0,0 +1,33 @@
+package commands
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestGetBranchNamesFromCommand(t *testing.T) {
+	tests := []struct {
+		command string
+		want    []string
+	}{
+		{command: "git b main", want: []string{"main"}},
+		{command: "git b main, develop", want: []string{"main", "develop"}},
+		{command: "git b 'feature/*', !dependabot/*", want: []string{"feature/*", "!dependabot/*"}},
+		{command: "git b re:^a{1,3}$", want: []string{"re:^a{1,3}$"}},
+		{command: "git b re:^a{1,3}$,main", want: []string{"re:^a{1,3}$", "main"}},
+		{command: "git b !re:^(x,y)-\\d+, release", want: []string{"!re:^(x,y)-\\d+", "release"}},
+		{command: "git b 're:[,;]end', main", want: []string{"re:[,;]end", "main"}},
+		{command: "git b re:a\\,b", want: []string{"re:a\\,b"}},
+		{command: "git b main,,", want: []string{"main"}},
+		{command: "git b ", want: nil},
+		{command: "git a main", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.command, func(t *testing.T) {
+			if got := GetBranchNamesFromCommand(tt.command); !slices.Equal(got, tt.want) {
+				t.Errorf("GetBranchNamesFromCommand(%q) = %q, want %q", tt.command, got, tt.want)
+			}
+		})
+	}
+}
@@ -70,6 +70,14 @@ func (r *Registry) GetHelpText() string {
 			descStyle.Render("Set analysis date (e.g., void sd 2025-06-01)"),
 		),
 	)
+	help.WriteString(
+		fmt.Sprintf("  %s %s %s - %s\n",
+			commandStyle.Render("void"),
+			sectionHeaderStyle.Render("range"),
+			argStyle.Render("<YYYY-MM-DD> <YYYY-MM-DD>"),
+			descStyle.Render("Set a closed analysis range, both days inclusive (e.g., void range 2025-05-01 2025-05-31)"),
+		),
+	)
 
 	return help.String()
 }
@@ -123,6 +131,117 @@ func (r *Registry) GetGitHelpText() string {
 			descStyle.Render("Set GitHub API token (needed for remote repositories)"),
 		),
 	)
+	help.WriteString(
+		fmt.Sprintf("  %s %s %s - %s\n",
+			commandStyle.Render("git"),
+			sectionHeaderStyle.Render("token gitlab"),
+			argStyle.Render("<gitlab-token>"),
+			descStyle.Render("Set GitLab personal access token (gitlab.com or self-hosted)"),
+		),
+	)
+	help.WriteString(
+		fmt.Sprintf("  %s %s %s - %s\n",
+			commandStyle.Render("git"),
+			sectionHeaderStyle.Render("token bitbucket"),
+			argStyle.Render("<username>:<app-password>"),
+			descStyle.Render("Set Bitbucket Cloud app password credentials"),
+		),
+	)
+	help.WriteString(
+		fmt.Sprintf("  %s %s %s - %s\n",
+			commandStyle.Render("git"),
+			sectionHeaderStyle.Render("token bitbucket-server"),
+			argStyle.Render("<http-token>"),
+			descStyle.Render("Set Bitbucket Server/Data Center HTTP access token"),
+		),
+	)
+	help.WriteString(
+		fmt.Sprintf("  %s %s %s - %s\n",
+			commandStyle.Render("git"),
+			sectionHeaderStyle.Render("repo token"),
+			argStyle.Render("<url> <token>"),
+			descStyle.Render("Set a token for one repository (e.g., a GitLab project access token)"),
+		),
+	)
+	help.WriteString(
+		fmt.Sprintf("  %s %s %s - %s\n",
+			commandStyle.Render("git"),
+			sectionHeaderStyle.Render("provider"),
+			argStyle.Render("<url> <github|gitlab|bitbucket|bitbucket-server|gitea|mirror>"),
+			descStyle.Render("Override the detected hosting provider for a repository"),
+		),
+	)
+	help.WriteString(
+		fmt.Sprintf("  %s %s %s - %s\n",
+			commandStyle.Render("git"),
+			sectionHeaderStyle.Render("host"),
+			argStyle.Render("<hostname> <provider> [api-url]"),
+			descStyle.Render("Route a host to a provider (e.g., git host gitea.mycorp.com gitea)"),
+		),
+	)
+	help.WriteString(
+		fmt.Sprintf("  %s %s %s - %s\n",
+			commandStyle.Render("git"),
+			sectionHeaderStyle.Render("host token"),
+			argStyle.Render("<hostname> <token>"),
+			descStyle.Render("Set the API token used for every repository on a host"),
+		),
+	)
+	help.WriteString(
+		fmt.Sprintf("  %s %s %s - %s\n",
+			commandStyle.Render("git"),
+			sectionHeaderStyle.Render("pages"),
+			argStyle.Render("<n>"),
+			descStyle.Render("Cap GitHub pagination at n pages of 100 per branch (default 10)"),
+		),
+	)
+	help.WriteString(
+		fmt.Sprintf("  %s %s %s - %s\n",
+			commandStyle.Render("git"),
+			sectionHeaderStyle.Render("concurrency"),
+			argStyle.Render("<n>"),
+			descStyle.Render("Keep at most n requests in flight per host, and load n repositories at a time (default 4)"),
+		),
+	)
+	help.WriteString(
+		fmt.Sprintf("  %s %s %s - %s\n",
+			commandStyle.Render("git"),
+			sectionHeaderStyle.Render("stats"),
+			argStyle.Render("<on|off>"),
+			descStyle.Render("Show lines added/removed and files changed per commit, with totals (GitHub rows load as you scroll)"),
+		),
+	)
+	help.WriteString(
+		fmt.Sprintf("  %s %s %s - %s\n",
+			commandStyle.Render("git"),
+			sectionHeaderStyle.Render("cache"),
+			argStyle.Render("clear"),
+			descStyle.Render("Clear cached GitHub API responses (refreshes use conditional requests)"),
+		),
+	)
+	help.WriteString(
+		fmt.Sprintf("  %s %s %s - %s\n",
+			commandStyle.Render("git"),
+			sectionHeaderStyle.Render("mirror"),
+			argStyle.Render("clear"),
+			descStyle.Render("Delete local mirrors of remote repositories (they are cloned again on next load)"),
+		),
+	)
+	help.WriteString(
+		fmt.Sprintf("  %s %s - %s\n",
+			commandStyle.Render("git"),
+			sectionHeaderStyle.Render("host list"),
+			descStyle.Render("List registered hosts"),
+		),
+	)
+	help.WriteString(
+		fmt.Sprintf("  %s %s %s - %s\n",
+			commandStyle.Render("git"),
+			sectionHeaderStyle.Render("host remove"),
+			argStyle.Render("<hostname>"),
+			descStyle.Render("Remove a registered host"),
+		),
+	)
 
 	help.WriteString(sectionHeaderStyle.Render("\nRepository Types:") + "\n")
 	help.WriteString(
@@ -137,6 +256,36 @@ func (r *Registry) GetGitHelpText() string {
 			descStyle.Render("https://github.com/user/repo, git@github.com:user/repo.git"),
 		),
 	)
+	help.WriteString(
+		fmt.Sprintf("  %s - %s\n",
+			descStyle.Render("GitLab"),
+			descStyle.Render("https://gitlab.com/group/subgroup/project, https://gitlab.mycorp.com/team/project"),
+		),
+	)
+	help.WriteString(
+		fmt.Sprintf("  %s - %s\n",
+			descStyle.Render("Bitbucket"),
+			descStyle.Render("https://bitbucket.org/workspace/repo, https://git.mycorp.com/scm/PROJ/repo.git"),
+		),
+	)
+	help.WriteString(
+		fmt.Sprintf("  %s - %s\n",
+			descStyle.Render("Gitea/Forgejo"),
+			descStyle.Render("https://codeberg.org/owner/repo, or any host registered with 'git host'"),
+		),
+	)
+	help.WriteString(
+		fmt.Sprintf("  %s - %s\n",
+			descStyle.Render("GitHub Enterprise"),
+			descStyle.Render("https://github.mycorp.com/org/repo after 'git host github.mycorp.com github'"),
+		),
+	)
+	help.WriteString(
+		fmt.Sprintf("  %s - %s\n",
+			descStyle.Render("Mirror"),
+			descStyle.Render("ssh://host/repo.git, file:///srv/repo.git, or any URL after 'git provider <url> mirror'"),
+		),
+	)
 
 	help.WriteString(sectionHeaderStyle.Render("\nMulti-Repository Support:") + "\n")
 	help.WriteString(
@@ -219,8 +368,16 @@ func (r *Registry) GetGitHelpText() string {
 		fmt.Sprintf("  %s %s %s - %s\n",
 			commandStyle.Render("git"),
 			sectionHeaderStyle.Render("author"),
-			argStyle.Render("<name>"),
-			descStyle.Render("Filter commits by author name. Comma-separated for multiple authors."),
+			argStyle.Render("<name|email|@domain>"),
+			descStyle.Render("Filter commits by author name or email. Comma-separated for multiple authors."),
+		),
+	)
+	help.WriteString(
+		fmt.Sprintf("  %s %s %s - %s\n",
+			commandStyle.Render("git"),
+			sectionHeaderStyle.Render("author"),
+			argStyle.Render("=<name> | --exact <names>"),
+			descStyle.Render("Match the full name or email only, without fuzzy word matching"),
 		),
 	)
 	help.WriteString(
@@ -231,6 +388,91 @@ func (r *Registry) GetGitHelpText() string {
 			descStyle.Render("Filter commits by branch name. Comma-separated for multiple branches."),
 		),
 	)
+	help.WriteString(
+		fmt.Sprintf("  %s %s %s - %s\n",
+			commandStyle.Render("git"),
+			sectionHeaderStyle.Render("branch"),
+			argStyle.Render("feature/* | re:<regex> | !<pattern>"),
+			descStyle.Render("Match branches by glob or regex; prefix with ! to exclude"),
+		),
+	)
+	help.WriteString(
+		fmt.Sprintf("  %s %s %s - %s\n",
+			commandStyle.Render("git"),
+			sectionHeaderStyle.Render("alias"),
+			argStyle.Render("<name-or-email> = <canonical name>"),
+			descStyle.Render("Show an author under one identity (applied after each repo's .mailmap)"),
+		),
+	)
+	help.WriteString(
+		fmt.Sprintf("  %s %s %s - %s\n",
+			commandStyle.Render("git"),
+			sectionHeaderStyle.Render("alias remove"),
+			argStyle.Render("<name-or-email>"),
+			descStyle.Render("Remove an author alias"),
+		),
+	)
+	help.WriteString(
+		fmt.Sprintf("  %s %s %s - %s\n",
+			commandStyle.Render("git"),
+			sectionHeaderStyle.Render("coauthors"),
+			argStyle.Render("<on|off>"),
+			descStyle.Render("Let the author filter match Co-authored-by and Signed-off-by trailers"),
+		),
+	)
+	help.WriteString(
+		fmt.Sprintf("  %s %s - %s\n",
+			commandStyle.Render("git"),
+			sectionHeaderStyle.Render("message"),
+			descStyle.Render("Clear message filter and show all commits"),
+		),
+	)
+	help.WriteString(
+		fmt.Sprintf("  %s %s %s - %s\n",
+			commandStyle.Render("git"),
+			sectionHeaderStyle.Render("message"),
+			argStyle.Render("[--case] <text|re:regex>"),
+			descStyle.Render("Show commits whose subject or body matches. Case-insensitive unless --case is given."),
+		),
+	)
+	help.WriteString(
+		fmt.Sprintf("  %s %s - %s\n",
+			commandStyle.Render("git"),
+			sectionHeaderStyle.Render("type"),
+			descStyle.Render("Clear commit type filter and show all commits"),
+		),
+	)
+	help.WriteString(
+		fmt.Sprintf("  %s %s %s - %s\n",
+			commandStyle.Render("git"),
+			sectionHeaderStyle.Render("type"),
+			argStyle.Render("<feat,fix,...|breaking>"),
+			descStyle.Render("Show Conventional Commits of these types. Comma-separated for multiple types."),
+		),
+	)
+	help.WriteString(
+		fmt.Sprintf("  %s %s - %s\n",
+			commandStyle.Render("git"),
+			sectionHeaderStyle.Render("path"),
+			descStyle.Render("Clear path filter and show all commits"),
+		),
+	)
+	help.WriteString(
+		fmt.Sprintf("  %s %s %s - %s\n",
+			commandStyle.Render("git"),
+			sectionHeaderStyle.Render("path"),
+			argStyle.Render("<dir|file|glob>"),
+			descStyle.Render("Show commits touching these paths. Globs need a local or GitHub repo. Comma-separated for multiple paths."),
+		),
+	)
+	help.WriteString(
+		fmt.Sprintf("  %s %s %s - %s\n",
+			commandStyle.Render("git"),
+			sectionHeaderStyle.Render("merges"),
+			argStyle.Render("<on|off|only>"),
+			descStyle.Render("Include merge commits (default), hide them, or show only them"),
+		),
+	)
 
 	return help.String()
 }
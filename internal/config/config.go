@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 )
 
@@ -23,9 +24,27 @@ type JiraConfig struct {
 }
 
 type GitConfig struct {
-	RepoURLs    []string `json:"repo_urls"`
-	RepoType    string   `json:"repo_type"`
-	GitHubToken string   `json:"github_token,omitempty"`
+	RepoURLs             []string           `json:"repo_urls"`
+	RepoType             string             `json:"repo_type"`
+	GitHubToken          string             `json:"github_token,omitempty"`
+	GitLabToken          string             `json:"gitlab_token,omitempty"`
+	BitbucketUsername    string             `json:"bitbucket_username,omitempty"`
+	BitbucketAppPassword string             `json:"bitbucket_app_password,omitempty"`
+	BitbucketServerToken string             `json:"bitbucket_server_token,omitempty"`
+	RepoProviders        map[string]string  `json:"repo_providers,omitempty"`
+	RepoTokens           map[string]string  `json:"repo_tokens,omitempty"`
+	Hosts                map[string]GitHost `json:"hosts,omitempty"`
+	MaxPages             int                `json:"max_pages,omitempty"`
+	Concurrency          int                `json:"concurrency,omitempty"`
+	ShowStats            bool               `json:"show_stats,omitempty"`
+	MatchCoAuthors       bool               `json:"match_co_authors,omitempty"`
+	AuthorAliases        map[string]string  `json:"author_aliases,omitempty"`
+}
+
+type GitHost struct {
+	Provider string `json:"provider,omitempty"`
+	APIURL   string `json:"api_url,omitempty"`
+	Token    string `json:"token,omitempty"`
 }
 
 const configFileName = ".project-void-config.json"
@@ -207,13 +226,40 @@ func SetGitConfig(key, value string) error {
 			}
 		}
 		config.Git.RepoURLs = append(config.Git.RepoURLs, value)
-		if strings.HasPrefix(value, "http://") || strings.HasPrefix(value, "https://") || strings.HasPrefix(value, "git@") {
+		if strings.HasPrefix(value, "http://") || strings.HasPrefix(value, "https://") || strings.HasPrefix(value, "git@") || strings.HasPrefix(value, "ssh://") || strings.HasPrefix(value, "git://") || strings.HasPrefix(value, "file://") {
 			config.Git.RepoType = "remote"
 		} else if config.Git.RepoType != "remote" {
 			config.Git.RepoType = "local"
 		}
-	case "token", "apitoken", "api_token":
+	case "token", "apitoken", "api_token", "github_token":
 		config.Git.GitHubToken = value
+	case "gitlab_token":
+		config.Git.GitLabToken = value
+	case "bitbucket_token":
+		username, appPassword, found := strings.Cut(value, ":")
+		if !found || username == "" || appPassword == "" {
+			return fmt.Errorf("invalid Bitbucket credentials. Use <username>:<app-password>")
+		}
+		config.Git.BitbucketUsername = username
+		config.Git.BitbucketAppPassword = appPassword
+	case "bitbucket-server_token", "bitbucket_server_token":
+		config.Git.BitbucketServerToken = value
+	case "pages", "max_pages":
+		maxPages, err := strconv.Atoi(value)
+		if err != nil || maxPages < 1 {
+			return fmt.Errorf("invalid page cap: %s. Use a positive number", value)
+		}
+		config.Git.MaxPages = maxPages
+	case "concurrency":
+		concurrency, err := strconv.Atoi(value)
+		if err != nil || concurrency < 1 {
+			return fmt.Errorf("invalid concurrency limit: %s. Use a positive number", value)
+		}
+		config.Git.Concurrency = concurrency
+	case "stats", "show_stats":
+		config.Git.ShowStats = strings.ToLower(value) == "true"
+	case "coauthors", "match_co_authors":
+		config.Git.MatchCoAuthors = strings.ToLower(value) == "true"
 	default:
 		return fmt.Errorf("unknown Git config key: %s", key)
 	}
@@ -229,6 +275,8 @@ func ClearGitRepo() error {
 
 	config.Git.RepoURLs = []string{}
 	config.Git.RepoType = "local"
+	config.Git.RepoProviders = nil
+	config.Git.RepoTokens = nil
 
 	return SaveUserConfig(config)
 }
@@ -254,13 +302,15 @@ func RemoveGitRepo(repoURL string) error {
 	}
 
 	config.Git.RepoURLs = updatedRepos
+	delete(config.Git.RepoProviders, repoURL)
+	delete(config.Git.RepoTokens, repoURL)
 
 	if len(config.Git.RepoURLs) == 0 {
 		config.Git.RepoType = "local"
 	} else {
 		hasRemote := false
 		for _, repo := range config.Git.RepoURLs {
-			if strings.HasPrefix(repo, "http://") || strings.HasPrefix(repo, "https://") || strings.HasPrefix(repo, "git@") {
+			if strings.HasPrefix(repo, "http://") || strings.HasPrefix(repo, "https://") || strings.HasPrefix(repo, "git@") || strings.HasPrefix(repo, "ssh://") || strings.HasPrefix(repo, "git://") || strings.HasPrefix(repo, "file://") {
 				hasRemote = true
 				break
 			}
@@ -275,6 +325,168 @@ func RemoveGitRepo(repoURL string) error {
 	return SaveUserConfig(config)
 }
 
+func SetGitRepoProvider(repoURL, provider string) error {
+	config, err := LoadUserConfig()
+	if err != nil {
+		return err
+	}
+
+	validProviders := map[string]bool{
+		"github":           true,
+		"gitlab":           true,
+		"bitbucket":        true,
+		"bitbucket-server": true,
+		"gitea":            true,
+		"mirror":           true,
+	}
+	if !validProviders[provider] {
+		return fmt.Errorf("invalid provider: %s. Valid options are: github, gitlab, bitbucket, bitbucket-server, gitea, mirror", provider)
+	}
+
+	if config.Git.RepoProviders == nil {
+		config.Git.RepoProviders = make(map[string]string)
+	}
+	config.Git.RepoProviders[repoURL] = provider
+
+	return SaveUserConfig(config)
+}
+
+func SetGitRepoToken(repoURL, token string) error {
+	config, err := LoadUserConfig()
+	if err != nil {
+		return err
+	}
+
+	if config.Git.RepoTokens == nil {
+		config.Git.RepoTokens = make(map[string]string)
+	}
+	if token == "" {
+		delete(config.Git.RepoTokens, repoURL)
+	} else {
+		config.Git.RepoTokens[repoURL] = token
+	}
+
+	return SaveUserConfig(config)
+}
+
+func NormalizeGitHost(host string) string {
+	host = strings.TrimSpace(strings.ToLower(host))
+	host = strings.TrimPrefix(host, "https://")
+	host = strings.TrimPrefix(host, "http://")
+	if idx := strings.Index(host, "/"); idx >= 0 {
+		host = host[:idx]
+	}
+	return host
+}
+
+func SetGitHost(host, provider, apiURL string) error {
+	config, err := LoadUserConfig()
+	if err != nil {
+		return err
+	}
+
+	if provider == "forgejo" {
+		provider = "gitea"
+	}
+
+	validProviders := map[string]bool{
+		"github":           true,
+		"gitlab":           true,
+		"gitea":            true,
+		"bitbucket-server": true,
+		"mirror":           true,
+	}
+	if !validProviders[provider] {
+		return fmt.Errorf("invalid host provider: %s. Valid options are: github, gitlab, gitea, forgejo, bitbucket-server, mirror", provider)
+	}
+
+	host = NormalizeGitHost(host)
+	if host == "" {
+		return fmt.Errorf("host name cannot be empty")
+	}
+
+	if config.Git.Hosts == nil {
+		config.Git.Hosts = make(map[string]GitHost)
+	}
+	entry := config.Git.Hosts[host]
+	entry.Provider = provider
+	entry.APIURL = strings.TrimSuffix(apiURL, "/")
+	config.Git.Hosts[host] = entry
+
+	return SaveUserConfig(config)
+}
+
+func SetGitHostToken(host, token string) error {
+	config, err := LoadUserConfig()
+	if err != nil {
+		return err
+	}
+
+	host = NormalizeGitHost(host)
+	if host == "" {
+		return fmt.Errorf("host name cannot be empty")
+	}
+
+	if config.Git.Hosts == nil {
+		config.Git.Hosts = make(map[string]GitHost)
+	}
+	entry := config.Git.Hosts[host]
+	entry.Token = token
+	config.Git.Hosts[host] = entry
+
+	return SaveUserConfig(config)
+}
+
+func RemoveGitHost(host string) error {
+	config, err := LoadUserConfig()
+	if err != nil {
+		return err
+	}
+
+	host = NormalizeGitHost(host)
+	if _, exists := config.Git.Hosts[host]; !exists {
+		return fmt.Errorf("host not found: %s", host)
+	}
+	delete(config.Git.Hosts, host)
+
+	return SaveUserConfig(config)
+}
+
+func SetGitAuthorAlias(alias, canonical string) error {
+	config, err := LoadUserConfig()
+	if err != nil {
+		return err
+	}
+
+	alias = strings.ToLower(strings.TrimSpace(alias))
+	canonical = strings.TrimSpace(canonical)
+	if alias == "" || canonical == "" {
+		return fmt.Errorf("alias and canonical name cannot be empty")
+	}
+
+	if config.Git.AuthorAliases == nil {
+		config.Git.AuthorAliases = make(map[string]string)
+	}
+	config.Git.AuthorAliases[alias] = canonical
+
+	return SaveUserConfig(config)
+}
+
+func RemoveGitAuthorAlias(alias string) error {
+	config, err := LoadUserConfig()
+	if err != nil {
+		return err
+	}
+
+	alias = strings.ToLower(strings.TrimSpace(alias))
+	if _, exists := config.Git.AuthorAliases[alias]; !exists {
+		return fmt.Errorf("alias not found: %s", alias)
+	}
+	delete(config.Git.AuthorAliases, alias)
+
+	return SaveUserConfig(config)
+}
+
 func ListGitRepos() ([]string, error) {
 	config, err := LoadUserConfig()
 	if err != nil {
@@ -0,0 +1,546 @@
+package git
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type BitbucketCloudProvider struct {
+	client      *http.Client
+	username    string
+	password    string
+	token       string
+	concurrency int
+}
+
+type BitbucketServerProvider struct {
+	client      *http.Client
+	token       string
+	baseURL     string
+	concurrency int
+}
+
+type BitbucketCloudCommit struct {
+	Hash   string `json:"hash"`
+	Author struct {
+		Raw  string `json:"raw"`
+		User *struct {
+			DisplayName string `json:"display_name"`
+		} `json:"user"`
+	} `json:"author"`
+	Date    time.Time `json:"date"`
+	Message string    `json:"message"`
+	Parents []struct {
+		Hash string `json:"hash"`
+	} `json:"parents"`
+}
+
+type BitbucketCloudBranch struct {
+	Name   string `json:"name"`
+	Target struct {
+		Hash string `json:"hash"`
+	} `json:"target"`
+}
+
+type BitbucketServerCommit struct {
+	ID     string `json:"id"`
+	Author struct {
+		Name         string `json:"name"`
+		EmailAddress string `json:"emailAddress"`
+		DisplayName  string `json:"displayName"`
+	} `json:"author"`
+	AuthorTimestamp int64 `json:"authorTimestamp"`
+	Committer       struct {
+		Name         string `json:"name"`
+		EmailAddress string `json:"emailAddress"`
+		DisplayName  string `json:"displayName"`
+	} `json:"committer"`
+	CommitterTimestamp int64  `json:"committerTimestamp"`
+	Message            string `json:"message"`
+	Parents            []struct {
+		ID string `json:"id"`
+	} `json:"parents"`
+}
+
+type BitbucketServerBranch struct {
+	ID           string `json:"id"`
+	DisplayID    string `json:"displayId"`
+	LatestCommit string `json:"latestCommit"`
+}
+
+const bitbucketPageSize = 100
+
+func NewBitbucketCloudProvider() *BitbucketCloudProvider {
+	return &BitbucketCloudProvider{
+		client:      &http.Client{Timeout: 30 * time.Second},
+		concurrency: DefaultConcurrency,
+	}
+}
+
+func (b *BitbucketCloudProvider) SetConcurrency(concurrency int) {
+	if concurrency > 0 {
+		b.concurrency = concurrency
+	}
+}
+
+func (b *BitbucketCloudProvider) SetToken(token string) {
+	b.token = token
+}
+
+func (b *BitbucketCloudProvider) SetCredentials(username, appPassword string) {
+	b.username = username
+	b.password = appPassword
+}
+
+func (b *BitbucketCloudProvider) parseBitbucketURL(repoURL string) (workspace, repoSlug string, err error) {
+	parts := strings.Split(getRepoPath(repoURL), "/")
+	if getRepoHost(repoURL) != "bitbucket.org" || len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid Bitbucket URL format: %s", repoURL)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func (b *BitbucketCloudProvider) makeRequest(ctx context.Context, url string) (*http.Response, error) {
+	return makeBitbucketRequest(ctx, b.client, url, b.username, b.password, b.token)
+}
+
+func (b *BitbucketCloudProvider) getBranches(ctx context.Context, workspace, repoSlug string) ([]BitbucketCloudBranch, error) {
+	nextURL := fmt.Sprintf("https://api.bitbucket.org/2.0/repositories/%s/%s/refs/branches?pagelen=%d", workspace, repoSlug, bitbucketPageSize)
+
+	var branches []BitbucketCloudBranch
+	for nextURL != "" {
+		resp, err := b.makeRequest(ctx, nextURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get branches for %s/%s: %w", workspace, repoSlug, err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("Bitbucket API error for %s/%s branches: HTTP %d", workspace, repoSlug, resp.StatusCode)
+		}
+
+		var page struct {
+			Values []BitbucketCloudBranch `json:"values"`
+			Next   string                 `json:"next"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode branches response for %s/%s: %w", workspace, repoSlug, err)
+		}
+
+		branches = append(branches, page.Values...)
+		nextURL = page.Next
+	}
+
+	return branches, nil
+}
+
+func (b *BitbucketCloudProvider) getCommitsFromBranch(ctx context.Context, workspace, repoSlug string, branch BitbucketCloudBranch, path string, since time.Time) ([]Commit, error) {
+	params := url.Values{}
+	params.Set("pagelen", strconv.Itoa(bitbucketPageSize))
+	if path != "" {
+		params.Set("path", path)
+	}
+	nextURL := fmt.Sprintf("https://api.bitbucket.org/2.0/repositories/%s/%s/commits/%s?%s", workspace, repoSlug, url.PathEscape(branch.Name), params.Encode())
+
+	var commits []Commit
+	for nextURL != "" {
+		resp, err := b.makeRequest(ctx, nextURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get commits for %s/%s branch %s: %w", workspace, repoSlug, branch.Name, err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("Bitbucket API error for %s/%s branch %s commits: HTTP %d", workspace, repoSlug, branch.Name, resp.StatusCode)
+		}
+
+		var page struct {
+			Values []BitbucketCloudCommit `json:"values"`
+			Next   string                 `json:"next"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode commits response for %s/%s branch %s: %w", workspace, repoSlug, branch.Name, err)
+		}
+
+		// Neither date bound can be sent upstream, so commits come back in
+		// the API's newest-first log order and are filtered here; Until is
+		// applied afterwards by applyCommitQuery. Paging stops at the first
+		// page without a recent commit, which assumes that order roughly
+		// follows commit dates: commits merged in with much older dates can
+		// end a branch early.
+		foundRecent := false
+		for _, bc := range page.Values {
+			if !bc.Date.After(since) {
+				continue
+			}
+			foundRecent = true
+
+			var parents []string
+			for _, parent := range bc.Parents {
+				parents = append(parents, parent.Hash)
+			}
+
+			author := bc.Author.Raw
+			if bc.Author.User != nil && bc.Author.User.DisplayName != "" {
+				author = bc.Author.User.DisplayName
+			} else if idx := strings.Index(author, " <"); idx >= 0 {
+				author = author[:idx]
+			}
+
+			commits = append(commits, Commit{
+				Hash:        bc.Hash,
+				Branch:      branch.Name,
+				Author:      author,
+				AuthorEmail: parseTrailerIdentity(bc.Author.Raw).Email,
+				Message:     bc.Message,
+				Timestamp:   bc.Date,
+
+				ParentCount: len(bc.Parents),
+				Parents:     parents,
+			})
+		}
+
+		if !foundRecent {
+			break
+		}
+		nextURL = page.Next
+	}
+
+	return commits, nil
+}
+
+func (b *BitbucketCloudProvider) getCommits(ctx context.Context, repoURL string, since time.Time, branchNames, pathPatterns []string) ([]Commit, error) {
+	workspace, repoSlug, err := b.parseBitbucketURL(repoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	branches, err := b.getBranches(ctx, workspace, repoSlug)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get branches: %w", err)
+	}
+
+	var matched []BitbucketCloudBranch
+	for _, branch := range branches {
+		if branchMatches(branch.Name, branchNames) {
+			matched = append(matched, branch)
+		}
+	}
+
+	paths := pathParams(pathPatterns)
+
+	branchCommits := make([][]Commit, len(matched)*len(paths))
+	branchErrors := make([]error, len(branchCommits))
+	err = runLimited(ctx, b.concurrency, len(branchCommits), func(ctx context.Context, i int) error {
+		branch := matched[i/len(paths)]
+		commits, err := b.getCommitsFromBranch(ctx, workspace, repoSlug, branch, paths[i%len(paths)], since)
+		if err != nil {
+			branchErrors[i] = &BranchError{Branch: branch.Name, Err: err}
+			return nil
+		}
+		branchCommits[i] = commits
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mergeBranchCommits(branchCommits), errors.Join(branchErrors...)
+}
+
+func (b *BitbucketCloudProvider) Query(ctx context.Context, repoURL string, query CommitQuery) ([]Commit, error) {
+	if hasPathGlobs(query.Paths) {
+		return nil, unsupportedPathGlobs("Bitbucket")
+	}
+
+	commits, err := b.getCommits(ctx, repoURL, query.Since, query.Branches, query.Paths)
+	if _, partial := partialErrors(err); !partial {
+		return nil, err
+	}
+
+	return applyCommitQuery(commits, query), err
+}
+
+func NewBitbucketServerProvider() *BitbucketServerProvider {
+	return &BitbucketServerProvider{
+		client:      &http.Client{Timeout: 30 * time.Second},
+		concurrency: DefaultConcurrency,
+	}
+}
+
+func (b *BitbucketServerProvider) SetConcurrency(concurrency int) {
+	if concurrency > 0 {
+		b.concurrency = concurrency
+	}
+}
+
+func (b *BitbucketServerProvider) SetToken(token string) {
+	b.token = token
+}
+
+func (b *BitbucketServerProvider) SetBaseURL(baseURL string) {
+	b.baseURL = strings.TrimSuffix(baseURL, "/")
+}
+
+func (b *BitbucketServerProvider) parseBitbucketServerURL(repoURL string) (apiBase, projectKey, repoSlug string, err error) {
+	host := getRepoHost(repoURL)
+	parts := strings.Split(getRepoPath(repoURL), "/")
+
+	scheme := "https"
+	if strings.HasPrefix(repoURL, "http://") {
+		scheme = "http"
+	}
+
+	for i, part := range parts {
+		switch {
+		case part == "scm" && i+2 < len(parts):
+			contextPath := strings.Join(parts[:i], "/")
+			return b.apiBaseFor(scheme, host, contextPath), parts[i+1], parts[i+2], nil
+		case (part == "projects" || part == "users") && i+3 < len(parts) && parts[i+2] == "repos":
+			contextPath := strings.Join(parts[:i], "/")
+			projectKey := parts[i+1]
+			if part == "users" {
+				projectKey = "~" + projectKey
+			}
+			return b.apiBaseFor(scheme, host, contextPath), projectKey, parts[i+3], nil
+		}
+	}
+
+	if strings.HasPrefix(repoURL, "git@") && len(parts) == 2 {
+		return b.apiBaseFor(scheme, host, ""), parts[0], parts[1], nil
+	}
+
+	return "", "", "", fmt.Errorf("invalid Bitbucket Server URL format: %s", repoURL)
+}
+
+func (b *BitbucketServerProvider) apiBaseFor(scheme, host, contextPath string) string {
+	if b.baseURL != "" {
+		return b.baseURL
+	}
+	return bitbucketServerAPIBase(scheme, host, contextPath)
+}
+
+func bitbucketServerAPIBase(scheme, host, contextPath string) string {
+	if contextPath != "" {
+		return fmt.Sprintf("%s://%s/%s/rest/api/1.0", scheme, host, contextPath)
+	}
+	return fmt.Sprintf("%s://%s/rest/api/1.0", scheme, host)
+}
+
+func (b *BitbucketServerProvider) makeRequest(ctx context.Context, url string) (*http.Response, error) {
+	return makeBitbucketRequest(ctx, b.client, url, "", "", b.token)
+}
+
+func (b *BitbucketServerProvider) getPaged(ctx context.Context, baseURL string, params url.Values, out func(resp *http.Response) (isLastPage bool, nextPageStart int, err error)) error {
+	start := 0
+	for {
+		params.Set("limit", strconv.Itoa(bitbucketPageSize))
+		params.Set("start", strconv.Itoa(start))
+
+		resp, err := b.makeRequest(ctx, baseURL+"?"+params.Encode())
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return fmt.Errorf("Bitbucket Server API error: HTTP %d", resp.StatusCode)
+		}
+
+		isLastPage, nextPageStart, err := out(resp)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		if isLastPage || nextPageStart <= start {
+			return nil
+		}
+		start = nextPageStart
+	}
+}
+
+func (b *BitbucketServerProvider) getBranches(ctx context.Context, apiBase, projectKey, repoSlug string) ([]BitbucketServerBranch, error) {
+	endpoint := fmt.Sprintf("%s/projects/%s/repos/%s/branches", apiBase, url.PathEscape(projectKey), url.PathEscape(repoSlug))
+
+	var branches []BitbucketServerBranch
+	err := b.getPaged(ctx, endpoint, url.Values{}, func(resp *http.Response) (bool, int, error) {
+		var page struct {
+			Values        []BitbucketServerBranch `json:"values"`
+			IsLastPage    bool                    `json:"isLastPage"`
+			NextPageStart int                     `json:"nextPageStart"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+			return true, 0, fmt.Errorf("failed to decode branches response for %s/%s: %w", projectKey, repoSlug, err)
+		}
+		branches = append(branches, page.Values...)
+		return page.IsLastPage, page.NextPageStart, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get branches for %s/%s: %w", projectKey, repoSlug, err)
+	}
+
+	return branches, nil
+}
+
+func (b *BitbucketServerProvider) getCommitsFromBranch(ctx context.Context, apiBase, projectKey, repoSlug string, branch BitbucketServerBranch, path string, since time.Time) ([]Commit, error) {
+	endpoint := fmt.Sprintf("%s/projects/%s/repos/%s/commits", apiBase, url.PathEscape(projectKey), url.PathEscape(repoSlug))
+	params := url.Values{}
+	params.Set("until", branch.ID)
+	if path != "" {
+		params.Set("path", path)
+	}
+
+	var commits []Commit
+	err := b.getPaged(ctx, endpoint, params, func(resp *http.Response) (bool, int, error) {
+		var page struct {
+			Values        []BitbucketServerCommit `json:"values"`
+			IsLastPage    bool                    `json:"isLastPage"`
+			NextPageStart int                     `json:"nextPageStart"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+			return true, 0, fmt.Errorf("failed to decode commits response for %s/%s branch %s: %w", projectKey, repoSlug, branch.DisplayID, err)
+		}
+
+		// As for Bitbucket Cloud, the commits endpoint cannot filter by date
+		// and paging stops at the first page without a recent commit.
+		foundRecent := false
+		for _, bc := range page.Values {
+			timestamp := time.UnixMilli(bc.AuthorTimestamp)
+			if !timestamp.After(since) {
+				continue
+			}
+			foundRecent = true
+
+			var parents []string
+			for _, parent := range bc.Parents {
+				parents = append(parents, parent.ID)
+			}
+
+			author := bc.Author.DisplayName
+			if author == "" {
+				author = bc.Author.Name
+			}
+
+			committer := bc.Committer.DisplayName
+			if committer == "" {
+				committer = bc.Committer.Name
+			}
+			var commitTime time.Time
+			if bc.CommitterTimestamp > 0 {
+				commitTime = time.UnixMilli(bc.CommitterTimestamp)
+			}
+
+			commits = append(commits, Commit{
+				Hash:        bc.ID,
+				Branch:      branch.DisplayID,
+				Author:      author,
+				AuthorEmail: bc.Author.EmailAddress,
+				Message:     bc.Message,
+				Timestamp:   timestamp,
+
+				Committer:      committer,
+				CommitterEmail: bc.Committer.EmailAddress,
+				CommitTime:     commitTime,
+
+				ParentCount: len(bc.Parents),
+				Parents:     parents,
+			})
+		}
+
+		if !foundRecent {
+			return true, 0, nil
+		}
+		return page.IsLastPage, page.NextPageStart, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commits for %s/%s branch %s: %w", projectKey, repoSlug, branch.DisplayID, err)
+	}
+
+	return commits, nil
+}
+
+func (b *BitbucketServerProvider) getCommits(ctx context.Context, repoURL string, since time.Time, branchNames, pathPatterns []string) ([]Commit, error) {
+	apiBase, projectKey, repoSlug, err := b.parseBitbucketServerURL(repoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	branches, err := b.getBranches(ctx, apiBase, projectKey, repoSlug)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get branches: %w", err)
+	}
+
+	var matched []BitbucketServerBranch
+	for _, branch := range branches {
+		if branchMatches(branch.DisplayID, branchNames) {
+			matched = append(matched, branch)
+		}
+	}
+
+	paths := pathParams(pathPatterns)
+
+	branchCommits := make([][]Commit, len(matched)*len(paths))
+	branchErrors := make([]error, len(branchCommits))
+	err = runLimited(ctx, b.concurrency, len(branchCommits), func(ctx context.Context, i int) error {
+		branch := matched[i/len(paths)]
+		commits, err := b.getCommitsFromBranch(ctx, apiBase, projectKey, repoSlug, branch, paths[i%len(paths)], since)
+		if err != nil {
+			branchErrors[i] = &BranchError{Branch: branch.DisplayID, Err: err}
+			return nil
+		}
+		branchCommits[i] = commits
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mergeBranchCommits(branchCommits), errors.Join(branchErrors...)
+}
+
+func (b *BitbucketServerProvider) Query(ctx context.Context, repoURL string, query CommitQuery) ([]Commit, error) {
+	if hasPathGlobs(query.Paths) {
+		return nil, unsupportedPathGlobs("Bitbucket Server")
+	}
+
+	commits, err := b.getCommits(ctx, repoURL, query.Since, query.Branches, query.Paths)
+	if _, partial := partialErrors(err); !partial {
+		return nil, err
+	}
+
+	return applyCommitQuery(commits, query), err
+}
+
+func makeBitbucketRequest(ctx context.Context, client *http.Client, url, username, password, token string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	} else if username != "" && password != "" {
+		req.SetBasicAuth(username, password)
+	}
+
+	resp, err := doLimited(client, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make HTTP request: %w", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,189 @@
+package git
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+type cachedResponse struct {
+	URL          string      `json:"url"`
+	ETag         string      `json:"etag,omitempty"`
+	LastModified string      `json:"last_modified,omitempty"`
+	Header       http.Header `json:"header"`
+	Body         []byte      `json:"body"`
+}
+
+type cacheTransport struct {
+	base http.RoundTripper
+	dir  string
+}
+
+var cachedHeaders = []string{"Content-Type", "Link", "ETag", "Last-Modified"}
+
+func newCacheTransport(base http.RoundTripper) http.RoundTripper {
+	dir, err := getHTTPCacheDir()
+	if err != nil {
+		return base
+	}
+
+	return &cacheTransport{base: base, dir: dir}
+}
+
+func getHTTPCacheDir() (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get cache directory: %w", err)
+	}
+
+	return filepath.Join(cacheDir, "project-void", "http"), nil
+}
+
+func ClearHTTPCache() error {
+	dir, err := getHTTPCacheDir()
+	if err != nil {
+		return err
+	}
+
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("failed to clear HTTP cache: %w", err)
+	}
+
+	return nil
+}
+
+func (t *cacheTransport) cacheKey(req *http.Request) string {
+	sum := sha256.Sum256([]byte(req.Header.Get("Authorization") + "\n" + req.URL.String()))
+	return hex.EncodeToString(sum[:])
+}
+
+func (t *cacheTransport) load(key string) (*cachedResponse, bool) {
+	data, err := os.ReadFile(filepath.Join(t.dir, key+".json"))
+	if err != nil {
+		return nil, false
+	}
+
+	var cached cachedResponse
+	if err := json.Unmarshal(data, &cached); err != nil {
+		return nil, false
+	}
+
+	return &cached, true
+}
+
+func (t *cacheTransport) store(key string, cached *cachedResponse) error {
+	if err := os.MkdirAll(t.dir, 0700); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	data, err := json.Marshal(cached)
+	if err != nil {
+		return fmt.Errorf("failed to marshal cached response: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(t.dir, key+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create cache file: %w", err)
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+	tmp.Close()
+
+	if err := os.Rename(tmp.Name(), filepath.Join(t.dir, key+".json")); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to save cache file: %w", err)
+	}
+
+	return nil
+}
+
+func (t *cacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		return t.base.RoundTrip(req)
+	}
+
+	key := t.cacheKey(req)
+	cached, hasCached := t.load(key)
+
+	if hasCached && cached.URL == req.URL.String() {
+		req = req.Clone(req.Context())
+		if cached.ETag != "" {
+			req.Header.Set("If-None-Match", cached.ETag)
+		}
+		if cached.LastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.LastModified)
+		}
+	} else {
+		hasCached = false
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && hasCached {
+		resp.Body.Close()
+
+		header := cached.Header.Clone()
+		for name, values := range resp.Header {
+			header[name] = values
+		}
+
+		return &http.Response{
+			Status:        "200 OK",
+			StatusCode:    http.StatusOK,
+			Proto:         resp.Proto,
+			ProtoMajor:    resp.ProtoMajor,
+			ProtoMinor:    resp.ProtoMinor,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewReader(cached.Body)),
+			ContentLength: int64(len(cached.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+
+	etag := resp.Header.Get("ETag")
+	lastModified := resp.Header.Get("Last-Modified")
+	if etag == "" && lastModified == "" {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	header := http.Header{}
+	for _, name := range cachedHeaders {
+		if value := resp.Header.Get(name); value != "" {
+			header.Set(name, value)
+		}
+	}
+
+	t.store(key, &cachedResponse{
+		URL:          req.URL.String(),
+		ETag:         etag,
+		LastModified: lastModified,
+		Header:       header,
+		Body:         body,
+	})
+
+	return resp, nil
+}
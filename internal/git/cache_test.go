@@ -0,0 +1,183 @@
+package git
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestCacheTransport(t *testing.T) {
+	const lastModified = "Wed, 21 Oct 2015 07:28:00 GMT"
+
+	tests := []struct {
+		name            string
+		validators      map[string]string
+		secondPath      string
+		secondAuth      string
+		wantConditional map[string]string
+		wantNotModified bool
+		wantBody        string
+	}{
+		{
+			name:            "etag is revalidated and replayed",
+			validators:      map[string]string{"ETag": `"v1"`},
+			wantConditional: map[string]string{"If-None-Match": `"v1"`, "If-Modified-Since": ""},
+			wantNotModified: true,
+			wantBody:        "body for /repos/a",
+		},
+		{
+			name:            "last-modified is revalidated and replayed",
+			validators:      map[string]string{"Last-Modified": lastModified},
+			wantConditional: map[string]string{"If-None-Match": "", "If-Modified-Since": lastModified},
+			wantNotModified: true,
+			wantBody:        "body for /repos/a",
+		},
+		{
+			name:            "response without validators is not cached",
+			validators:      map[string]string{},
+			wantConditional: map[string]string{"If-None-Match": "", "If-Modified-Since": ""},
+			wantBody:        "body for /repos/a",
+		},
+		{
+			name:            "other token does not share the entry",
+			validators:      map[string]string{"ETag": `"v1"`},
+			secondAuth:      "token other",
+			wantConditional: map[string]string{"If-None-Match": "", "If-Modified-Since": ""},
+			wantBody:        "body for /repos/a",
+		},
+		{
+			name:            "other url does not share the entry",
+			validators:      map[string]string{"ETag": `"v1"`},
+			secondPath:      "/repos/b",
+			wantConditional: map[string]string{"If-None-Match": "", "If-Modified-Since": ""},
+			wantBody:        "body for /repos/b",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mu       sync.Mutex
+				requests []http.Header
+			)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				requests = append(requests, r.Header.Clone())
+				count := len(requests)
+				mu.Unlock()
+
+				w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(100-count))
+				etag, modified := tt.validators["ETag"], tt.validators["Last-Modified"]
+				if (etag != "" && r.Header.Get("If-None-Match") == etag) ||
+					(modified != "" && r.Header.Get("If-Modified-Since") == modified) {
+					w.WriteHeader(http.StatusNotModified)
+					return
+				}
+
+				for name, value := range tt.validators {
+					w.Header().Set(name, value)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				io.WriteString(w, "body for "+r.URL.Path)
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: &cacheTransport{base: http.DefaultTransport, dir: t.TempDir()}}
+			get := func(path, auth string) *http.Response {
+				req, err := http.NewRequest(http.MethodGet, server.URL+path, nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+				req.Header.Set("Authorization", auth)
+				resp, err := client.Do(req)
+				if err != nil {
+					t.Fatalf("request failed: %v", err)
+				}
+				return resp
+			}
+
+			first := get("/repos/a", "token one")
+			io.Copy(io.Discard, first.Body)
+			first.Body.Close()
+
+			secondPath, secondAuth := "/repos/a", "token one"
+			if tt.secondPath != "" {
+				secondPath = tt.secondPath
+			}
+			if tt.secondAuth != "" {
+				secondAuth = tt.secondAuth
+			}
+
+			second := get(secondPath, secondAuth)
+			body, err := io.ReadAll(second.Body)
+			second.Body.Close()
+			if err != nil {
+				t.Fatalf("failed to read body: %v", err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if len(requests) != 2 {
+				t.Fatalf("server saw %d requests, want 2", len(requests))
+			}
+			for name, want := range tt.wantConditional {
+				if got := requests[1].Get(name); got != want {
+					t.Errorf("second request %s = %q, want %q", name, got, want)
+				}
+			}
+
+			if second.StatusCode != http.StatusOK {
+				t.Errorf("status = %d, want %d", second.StatusCode, http.StatusOK)
+			}
+			if string(body) != tt.wantBody {
+				t.Errorf("body = %q, want %q", body, tt.wantBody)
+			}
+			if got := second.Header.Get("Content-Type"); got != "application/json" {
+				t.Errorf("Content-Type = %q, want %q", got, "application/json")
+			}
+			if tt.wantNotModified {
+				if got := second.Header.Get("X-RateLimit-Remaining"); got != "98" {
+					t.Errorf("X-RateLimit-Remaining = %q, want the 304 response's %q", got, "98")
+				}
+			}
+		})
+	}
+}
+
+func TestCacheTransportIgnoresMismatchedEntry(t *testing.T) {
+	ifNoneMatch := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ifNoneMatch <- r.Header.Get("If-None-Match")
+		io.WriteString(w, "fresh")
+	}))
+	defer server.Close()
+
+	transport := &cacheTransport{base: http.DefaultTransport, dir: t.TempDir()}
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/repos/a", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// An entry stored under this request's key for another URL, as after a
+	// key collision, must not be used to revalidate or answer the request.
+	if err := transport.store(transport.cacheKey(req), &cachedResponse{URL: server.URL + "/repos/b", ETag: `"stale"`, Body: []byte("stale")}); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := (&http.Client{Transport: transport}).Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if got := <-ifNoneMatch; got != "" {
+		t.Errorf("If-None-Match = %q, want none", got)
+	}
+	if string(body) != "fresh" {
+		t.Errorf("body = %q, want %q", body, "fresh")
+	}
+}
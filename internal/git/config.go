@@ -4,13 +4,33 @@ import (
 	"fmt"
 	"os"
 	"project-void/internal/config"
+	"sort"
 	"strings"
 )
 
 type GitConfig struct {
-	RepoURLs    []string `json:"repo_urls"`
-	RepoType    string   `json:"repo_type"`
-	GitHubToken string   `json:"github_token,omitempty"`
+	RepoURLs             []string                  `json:"repo_urls"`
+	RepoType             string                    `json:"repo_type"`
+	GitHubToken          string                    `json:"github_token,omitempty"`
+	GitLabToken          string                    `json:"gitlab_token,omitempty"`
+	BitbucketUsername    string                    `json:"bitbucket_username,omitempty"`
+	BitbucketAppPassword string                    `json:"bitbucket_app_password,omitempty"`
+	BitbucketServerToken string                    `json:"bitbucket_server_token,omitempty"`
+	RepoProviders        map[string]string         `json:"repo_providers,omitempty"`
+	RepoTokens           map[string]string         `json:"repo_tokens,omitempty"`
+	Hosts                map[string]config.GitHost `json:"hosts,omitempty"`
+	MaxPages             int                       `json:"max_pages,omitempty"`
+	Concurrency          int                       `json:"concurrency,omitempty"`
+	ShowStats            bool                      `json:"show_stats,omitempty"`
+	MatchCoAuthors       bool                      `json:"match_co_authors,omitempty"`
+	AuthorAliases        map[string]string         `json:"author_aliases,omitempty"`
+}
+
+func (c *GitConfig) concurrencyLimit() int {
+	if c.Concurrency > 0 {
+		return c.Concurrency
+	}
+	return DefaultConcurrency
 }
 
 func LoadGitConfig() (*GitConfig, error) {
@@ -20,9 +40,25 @@ func LoadGitConfig() (*GitConfig, error) {
 	}
 
 	gitConfig := &GitConfig{
-		RepoURLs:    userConfig.Git.RepoURLs,
-		RepoType:    userConfig.Git.RepoType,
-		GitHubToken: userConfig.Git.GitHubToken,
+		RepoURLs:             userConfig.Git.RepoURLs,
+		RepoType:             userConfig.Git.RepoType,
+		GitHubToken:          userConfig.Git.GitHubToken,
+		GitLabToken:          userConfig.Git.GitLabToken,
+		BitbucketUsername:    userConfig.Git.BitbucketUsername,
+		BitbucketAppPassword: userConfig.Git.BitbucketAppPassword,
+		BitbucketServerToken: userConfig.Git.BitbucketServerToken,
+		RepoProviders:        userConfig.Git.RepoProviders,
+		RepoTokens:           userConfig.Git.RepoTokens,
+		Hosts:                userConfig.Git.Hosts,
+		MaxPages:             userConfig.Git.MaxPages,
+		Concurrency:          userConfig.Git.Concurrency,
+		ShowStats:            userConfig.Git.ShowStats,
+		MatchCoAuthors:       userConfig.Git.MatchCoAuthors,
+		AuthorAliases:        userConfig.Git.AuthorAliases,
+	}
+
+	if gitConfig.GitLabToken == "" {
+		gitConfig.GitLabToken = os.Getenv("GITLAB_TOKEN")
 	}
 
 	if len(gitConfig.RepoURLs) == 0 {
@@ -96,6 +132,76 @@ func GetGitStatus() (string, error) {
 	return status.String(), nil
 }
 
+func formatGitHosts(hosts map[string]config.GitHost, indent string) string {
+	names := make([]string, 0, len(hosts))
+	for name := range hosts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var out strings.Builder
+	for _, name := range names {
+		host := hosts[name]
+		provider := host.Provider
+		if provider == "" {
+			provider = "auto"
+		}
+		line := fmt.Sprintf("%s%s → %s", indent, name, provider)
+		if host.APIURL != "" {
+			line += fmt.Sprintf(" (%s)", host.APIURL)
+		}
+		if host.Token != "" {
+			line += fmt.Sprintf(" token %s", maskToken(host.Token))
+		}
+		out.WriteString(line + "\n")
+	}
+	return out.String()
+}
+
+func GetGitHostsStatus() (string, error) {
+	gitConfig, err := LoadGitConfig()
+	if err != nil {
+		return "", fmt.Errorf("failed to load git config: %w", err)
+	}
+
+	if len(gitConfig.Hosts) == 0 {
+		return "No Git hosts registered\nUse 'git host <hostname> <provider> [api-url]' to register one", nil
+	}
+
+	return fmt.Sprintf("Registered Git Hosts (%d):\n%s", len(gitConfig.Hosts), formatGitHosts(gitConfig.Hosts, "  ")), nil
+}
+
+func GetGitAliasesStatus() (string, error) {
+	gitConfig, err := LoadGitConfig()
+	if err != nil {
+		return "", fmt.Errorf("failed to load git config: %w", err)
+	}
+
+	if len(gitConfig.AuthorAliases) == 0 {
+		return "No author aliases configured\nUse 'git alias <name-or-email> = <canonical name>' to add one", nil
+	}
+
+	byCanonical := make(map[string][]string)
+	for alias, canonical := range gitConfig.AuthorAliases {
+		byCanonical[canonical] = append(byCanonical[canonical], alias)
+	}
+
+	canonicals := make([]string, 0, len(byCanonical))
+	for canonical := range byCanonical {
+		canonicals = append(canonicals, canonical)
+	}
+	sort.Strings(canonicals)
+
+	var status strings.Builder
+	status.WriteString(fmt.Sprintf("Author Aliases (%d):\n", len(gitConfig.AuthorAliases)))
+	for _, canonical := range canonicals {
+		aliases := byCanonical[canonical]
+		sort.Strings(aliases)
+		status.WriteString(fmt.Sprintf("  %s ← %s\n", canonical, strings.Join(aliases, ", ")))
+	}
+	return status.String(), nil
+}
+
 func ShouldEnableDevMode() bool {
 	gitConfig, err := LoadGitConfig()
 	if err != nil {
@@ -127,7 +233,11 @@ func GetGitConfigStatus() (string, error) {
 	if len(gitConfig.RepoURLs) > 0 {
 		status.WriteString(fmt.Sprintf("  Repositories (%d configured):\n", len(gitConfig.RepoURLs)))
 		for i, repo := range gitConfig.RepoURLs {
-			status.WriteString(fmt.Sprintf("    %d. %s\n", i+1, repo))
+			if isRemoteURL(repo) {
+				status.WriteString(fmt.Sprintf("    %d. %s [%s]\n", i+1, repo, providerTypeFor(repo, gitConfig)))
+			} else {
+				status.WriteString(fmt.Sprintf("    %d. %s\n", i+1, repo))
+			}
 		}
 		status.WriteString(fmt.Sprintf("  Type: %s\n", gitConfig.RepoType))
 	} else {
@@ -136,32 +246,117 @@ func GetGitConfigStatus() (string, error) {
 	}
 
 	if gitConfig.GitHubToken != "" {
-		masked := gitConfig.GitHubToken
-		if len(masked) > 8 {
-			masked = masked[:4] + "..." + masked[len(masked)-4:]
-		}
-		status.WriteString(fmt.Sprintf("  GitHub Token: %s\n", masked))
-		status.WriteString("  Rate Limit: 5,000 requests/hour (authenticated)\n")
+		status.WriteString(fmt.Sprintf("  GitHub Token: %s\n", maskToken(gitConfig.GitHubToken)))
 	} else {
 		status.WriteString("  GitHub Token: (not set)\n")
-		status.WriteString("  Rate Limit: 60 requests/hour (unauthenticated)\n")
+	}
+	status.WriteString(fmt.Sprintf("  Rate Limit: %s\n", GetGitHubRateLimitSummary(gitConfig.GitHubToken)))
+
+	maxPages := gitConfig.MaxPages
+	if maxPages == 0 {
+		maxPages = DefaultMaxPages
+	}
+	status.WriteString(fmt.Sprintf("  GitHub Page Cap: %d pages of 100 per branch\n", maxPages))
+	status.WriteString(fmt.Sprintf("  Concurrency: %d parallel requests per host\n", gitConfig.concurrencyLimit()))
+	if gitConfig.ShowStats {
+		status.WriteString("  Diff Stats: on\n")
+	} else {
+		status.WriteString("  Diff Stats: off\n")
+	}
+	if gitConfig.MatchCoAuthors {
+		status.WriteString("  Author Filter: matches co-authors\n")
+	} else {
+		status.WriteString("  Author Filter: commit author only\n")
+	}
+
+	if gitConfig.GitLabToken != "" {
+		status.WriteString(fmt.Sprintf("  GitLab Token: %s\n", maskToken(gitConfig.GitLabToken)))
+	} else {
+		status.WriteString("  GitLab Token: (not set)\n")
+	}
+
+	if gitConfig.BitbucketUsername != "" && gitConfig.BitbucketAppPassword != "" {
+		status.WriteString(fmt.Sprintf("  Bitbucket Cloud: %s (app password %s)\n", gitConfig.BitbucketUsername, maskToken(gitConfig.BitbucketAppPassword)))
+	} else {
+		status.WriteString("  Bitbucket Cloud: (not set)\n")
+	}
+
+	if gitConfig.BitbucketServerToken != "" {
+		status.WriteString(fmt.Sprintf("  Bitbucket Server Token: %s\n", maskToken(gitConfig.BitbucketServerToken)))
+	} else {
+		status.WriteString("  Bitbucket Server Token: (not set)\n")
+	}
+
+	if len(gitConfig.RepoTokens) > 0 {
+		status.WriteString(fmt.Sprintf("  Per-repository Tokens: %d configured\n", len(gitConfig.RepoTokens)))
+	}
+
+	if len(gitConfig.AuthorAliases) > 0 {
+		status.WriteString(fmt.Sprintf("  Author Aliases: %d configured\n", len(gitConfig.AuthorAliases)))
+	}
+
+	if len(gitConfig.Hosts) > 0 {
+		status.WriteString(fmt.Sprintf("  Registered Hosts (%d):\n", len(gitConfig.Hosts)))
+		status.WriteString(formatGitHosts(gitConfig.Hosts, "    "))
 	}
 
 	if len(gitConfig.RepoURLs) > 0 {
 		status.WriteString("\n✓ Git repositories are configured!")
 		hasGitHub := false
+		hasGitLab := false
+		hasBitbucket := false
 		for _, repo := range gitConfig.RepoURLs {
-			if strings.Contains(repo, "github.com") {
+			if !isRemoteURL(repo) {
+				continue
+			}
+			switch providerTypeFor(repo, gitConfig) {
+			case "github":
 				hasGitHub = true
-				break
+			case "gitlab":
+				hasGitLab = true
+			case "bitbucket":
+				hasBitbucket = true
 			}
 		}
 		if gitConfig.GitHubToken == "" && hasGitHub {
 			status.WriteString("\n💡 Tip: Set a GitHub token with 'git token <token>' for higher rate limits")
 		}
+		if gitConfig.GitLabToken == "" && hasGitLab {
+			status.WriteString("\n💡 Tip: Set a GitLab token with 'git token gitlab <token>' to access private projects")
+		}
+		if gitConfig.BitbucketAppPassword == "" && hasBitbucket {
+			status.WriteString("\n💡 Tip: Set Bitbucket credentials with 'git token bitbucket <username>:<app-password>'")
+		}
 	} else {
 		status.WriteString("\n⚠ No Git repositories configured. Use 'git repo <url>' to add repositories.")
 	}
 
 	return status.String(), nil
 }
+
+func GetGitHubRateLimitSummary(token string) string {
+	rateLimit := GitHubRateLimit()
+	if !rateLimit.Known {
+		if token != "" {
+			return "5,000 requests/hour (authenticated, live quota shown after the next load)"
+		}
+		return "60 requests/hour (unauthenticated, live quota shown after the next load)"
+	}
+
+	return rateLimit.String()
+}
+
+func HasGitHubRepos(repoPathsOrURLs []string) bool {
+	gitConfig, err := LoadGitConfig()
+	if err != nil {
+		gitConfig = &GitConfig{}
+	}
+
+	for _, repo := range repoPathsOrURLs {
+		if isRemoteURL(repo) && getRepoHost(repo) == "github.com" && providerTypeFor(repo, gitConfig) == "github" {
+			return true
+		}
+	}
+
+	return false
+}
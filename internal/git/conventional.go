@@ -0,0 +1,48 @@
+package git
+
+import (
+	"regexp"
+	"strings"
+)
+
+var conventionalSubjectPattern = regexp.MustCompile(`^([A-Za-z]+)(?:\(([^()]*)\))?(!)?: \S`)
+
+func parseConventionalCommit(message string) (string, string, bool) {
+	subject, body, _ := strings.Cut(message, "\n")
+
+	match := conventionalSubjectPattern.FindStringSubmatch(strings.TrimSpace(subject))
+	if match == nil {
+		return "", "", false
+	}
+
+	breaking := match[3] == "!"
+	for _, line := range strings.Split(body, "\n") {
+		if strings.HasPrefix(line, "BREAKING CHANGE:") || strings.HasPrefix(line, "BREAKING-CHANGE:") {
+			breaking = true
+			break
+		}
+	}
+
+	return strings.ToLower(match[1]), strings.TrimSpace(match[2]), breaking
+}
+
+func annotateConventionalCommits(commits []Commit) {
+	for i := range commits {
+		commits[i].Type, commits[i].Scope, commits[i].Breaking = parseConventionalCommit(commits[i].Message)
+	}
+}
+
+func filterCommitsByTypes(commits []Commit, types []string) []Commit {
+	var filtered []Commit
+	for _, commit := range commits {
+		for _, commitType := range types {
+			commitType = strings.ToLower(commitType)
+			if commitType == commit.Type || (commitType == "breaking" && commit.Breaking) {
+				filtered = append(filtered, commit)
+				break
+			}
+		}
+	}
+
+	return filtered
+}
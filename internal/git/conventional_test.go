@@ -0,0 +1,53 @@
+package git
+
+import "testing"
+
+func TestParseConventionalCommit(t *testing.T) {
+	tests := []struct {
+		name         string
+		message      string
+		wantType     string
+		wantScope    string
+		wantBreaking bool
+	}{
+		{name: "type only", message: "fix: handle empty input", wantType: "fix"},
+		{name: "type and scope", message: "feat(auth): add login", wantType: "feat", wantScope: "auth"},
+		{name: "scope with bang", message: "feat(api)!: drop v1 endpoints", wantType: "feat", wantScope: "api", wantBreaking: true},
+		{name: "bang without scope", message: "refactor!: rename package", wantType: "refactor", wantBreaking: true},
+		{name: "type is lowercased", message: "FIX: typo", wantType: "fix"},
+		{name: "scope is trimmed", message: "docs( readme ): fix link", wantType: "docs", wantScope: "readme"},
+		{name: "empty scope", message: "chore(): bump deps", wantType: "chore"},
+		{
+			name:         "breaking change footer",
+			message:      "feat(db): switch driver\n\nBREAKING CHANGE: requires Postgres 14",
+			wantType:     "feat",
+			wantScope:    "db",
+			wantBreaking: true,
+		},
+		{
+			name:         "breaking change hyphen footer",
+			message:      "fix: tighten validation\n\nBREAKING-CHANGE: empty names are rejected",
+			wantType:     "fix",
+			wantBreaking: true,
+		},
+		{name: "lowercase footer is not breaking", message: "fix: tighten validation\n\nbreaking change: maybe", wantType: "fix"},
+		{name: "footer without conventional subject", message: "Tighten validation\n\nBREAKING CHANGE: empty names are rejected"},
+		{name: "plain subject", message: "Update README"},
+		{name: "merge commit", message: "Merge branch 'main' into feature/login"},
+		{name: "missing space after colon", message: "fix:typo"},
+		{name: "empty description", message: "fix: "},
+		{name: "nested parentheses", message: "feat(a(b)): nested"},
+		{name: "non-letter type", message: "v2: release"},
+		{name: "empty message", message: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotType, gotScope, gotBreaking := parseConventionalCommit(tt.message)
+			if gotType != tt.wantType || gotScope != tt.wantScope || gotBreaking != tt.wantBreaking {
+				t.Errorf("parseConventionalCommit(%q) = %q, %q, %v, want %q, %q, %v",
+					tt.message, gotType, gotScope, gotBreaking, tt.wantType, tt.wantScope, tt.wantBreaking)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+type FileChange struct {
+	Path      string
+	Additions int
+	Deletions int
+}
+
+type CommitFilesResult struct {
+	Hash  string
+	Files []FileChange
+	Err   error
+}
+
+var ErrCommitFilesUnsupported = errors.New("changed files are not available for this provider")
+
+type CommitFilesProvider interface {
+	CommitFiles(ctx context.Context, repoURL, hash string) ([]FileChange, error)
+}
+
+func GetCommitFiles(ctx context.Context, repoPathOrURL, hash string) ([]FileChange, error) {
+	if !isRemoteURL(repoPathOrURL) {
+		return getCommitFilesLocal(repoPathOrURL, hash)
+	}
+
+	provider, err := detectProvider(repoPathOrURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect Git provider: %w", err)
+	}
+
+	filesProvider, ok := provider.(CommitFilesProvider)
+	if !ok {
+		return nil, ErrCommitFilesUnsupported
+	}
+
+	return filesProvider.CommitFiles(ctx, repoPathOrURL, hash)
+}
+
+// GetCommitFilesBatch loads the changed files of several commits at once,
+// sharing the configured per-host request limit between them.
+func GetCommitFilesBatch(ctx context.Context, commits []Commit) []CommitFilesResult {
+	gitConfig, err := LoadGitConfig()
+	if err != nil {
+		gitConfig = &GitConfig{}
+	}
+
+	ctx = withRequestLimit(ctx, gitConfig.concurrencyLimit())
+
+	results := make([]CommitFilesResult, len(commits))
+	loaded := make([]bool, len(commits))
+	err = runLimited(ctx, gitConfig.concurrencyLimit(), len(commits), func(ctx context.Context, i int) error {
+		files, err := GetCommitFiles(ctx, commits[i].Source, commits[i].Hash)
+		results[i] = CommitFilesResult{Hash: commits[i].Hash, Files: files, Err: err}
+		loaded[i] = true
+		return nil
+	})
+
+	for i, commit := range commits {
+		if !loaded[i] {
+			results[i] = CommitFilesResult{Hash: commit.Hash, Err: err}
+		}
+	}
+
+	return results
+}
+
+func getCommitFilesLocal(repoPath, hash string) ([]FileChange, error) {
+	repo, err := git.PlainOpen(repoPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open repository: %w", err)
+	}
+
+	c, err := repo.CommitObject(plumbing.NewHash(hash))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read commit %s: %w", hash, err)
+	}
+
+	fileStats, err := c.Stats()
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff commit %s: %w", hash, err)
+	}
+
+	files := make([]FileChange, len(fileStats))
+	for i, fileStat := range fileStats {
+		files[i] = FileChange{Path: fileStat.Name, Additions: fileStat.Addition, Deletions: fileStat.Deletion}
+	}
+
+	return files, nil
+}
@@ -1,220 +1,183 @@
 package git
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"sort"
 	"strings"
 	"time"
-
-	"project-void/internal/helpers"
-
-	"github.com/go-git/go-git/v5"
-	"github.com/go-git/go-git/v5/plumbing"
-	"github.com/go-git/go-git/v5/plumbing/object"
 )
 
 type Commit struct {
-	Hash      string
-	Branch    string
-	Author    string
-	Message   string
-	Timestamp time.Time
-	RepoName  string
-	RepoType  string
+	Hash        string
+	Branch      string
+	Branches    []string
+	Author      string
+	AuthorEmail string
+	Message     string
+	Timestamp   time.Time
+	RepoName    string
+	RepoType    string
+	Source      string
+
+	Committer      string
+	CommitterEmail string
+	CommitTime     time.Time
+
+	ParentCount int
+	Parents     []string
+	CoAuthors   []CoAuthor
+
+	Type     string
+	Scope    string
+	Breaking bool
+
+	Additions    int
+	Deletions    int
+	FilesChanged int
+	HasStats     bool
 }
 
-type GitProvider interface {
-	GetCommitsSince(repoURL string, since time.Time) ([]Commit, error)
-	GetCommitsSinceByAuthors(repoURL string, since time.Time, authorNames []string) ([]Commit, error)
-	GetCommitsSinceByBranches(repoURL string, since time.Time, branchNames []string) ([]Commit, error)
-	GetCommitsSinceByAuthorsAndBranches(repoURL string, since time.Time, authorNames []string, branchNames []string) ([]Commit, error)
+type TruncatedError struct {
+	Sources  []string
+	MaxPages int
 }
 
-func GetCommitsSince(repoPathOrURL string, since time.Time) ([]Commit, error) {
-	var commits []Commit
-	var err error
-
-	if isRemoteURL(repoPathOrURL) {
-		provider, err := detectProvider(repoPathOrURL)
-		if err != nil {
-			return nil, fmt.Errorf("failed to detect Git provider: %w", err)
-		}
-		commits, err = provider.GetCommitsSince(repoPathOrURL, since)
-	} else {
-		commits, err = getCommitsSinceLocal(repoPathOrURL, since)
-	}
-
-	if err != nil {
-		return nil, err
-	}
+func (e *TruncatedError) Error() string {
+	return fmt.Sprintf("results truncated at %d pages for %s", e.MaxPages, strings.Join(e.Sources, ", "))
+}
 
-	repoName := getRepoDisplayName(repoPathOrURL)
-	repoType := "local"
-	if isRemoteURL(repoPathOrURL) {
-		repoType = "remote"
-	}
+func IsTruncated(err error) bool {
+	var truncated *TruncatedError
+	return errors.As(err, &truncated)
+}
 
-	for i := range commits {
-		commits[i].RepoName = repoName
-		commits[i].RepoType = repoType
-	}
+type CommitQuery struct {
+	Since    time.Time
+	Until    time.Time
+	Authors  []string
+	Branches []string
+	Stats    bool
+	Merges   string
+	Paths    []string
+	Types    []string
+
+	Message              string
+	MessageCaseSensitive bool
+
+	MatchCoAuthors bool
+	Aliases        map[string]string
+}
 
-	return commits, nil
+type GitProvider interface {
+	Query(ctx context.Context, repoURL string, query CommitQuery) ([]Commit, error)
 }
 
-func GetCommitsSinceByAuthors(repoPathOrURL string, since time.Time, authorNames []string) ([]Commit, error) {
-	var commits []Commit
-	var err error
+type SourceError struct {
+	Source string
+	Err    error
+}
 
-	if isRemoteURL(repoPathOrURL) {
-		provider, err := detectProvider(repoPathOrURL)
-		if err != nil {
-			return nil, fmt.Errorf("failed to detect Git provider: %w", err)
-		}
-		commits, err = provider.GetCommitsSinceByAuthors(repoPathOrURL, since, authorNames)
-	} else {
-		commits, err = getCommitsSinceByAuthorsLocal(repoPathOrURL, since, authorNames)
-	}
+func (e SourceError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Source, e.Err)
+}
 
-	if err != nil {
-		return nil, err
-	}
+type BranchError struct {
+	Branch string
+	Err    error
+}
 
-	repoName := getRepoDisplayName(repoPathOrURL)
-	repoType := "local"
-	if isRemoteURL(repoPathOrURL) {
-		repoType = "remote"
-	}
+func (e *BranchError) Error() string {
+	return fmt.Sprintf("branch %s: %v", e.Branch, e.Err)
+}
 
-	for i := range commits {
-		commits[i].RepoName = repoName
-		commits[i].RepoType = repoType
-	}
+func (e *BranchError) Unwrap() error {
+	return e.Err
+}
 
-	return commits, nil
+type CommitResult struct {
+	Commits   []Commit
+	Failures  []SourceError
+	Truncated *TruncatedError
 }
 
-func GetCommitsSinceByAuthorsFromMultipleRepos(repoPathsOrURLs []string, since time.Time, authorNames []string) ([]Commit, error) {
+func GetCommits(ctx context.Context, repoPathsOrURLs []string, query CommitQuery) (*CommitResult, error) {
+	result := &CommitResult{Commits: []Commit{}}
 	if len(repoPathsOrURLs) == 0 {
-		return []Commit{}, nil
+		return result, nil
 	}
 
-	allCommits := make(map[string]Commit)
-	var errors []string
-
-	for _, repo := range repoPathsOrURLs {
-		commits, err := GetCommitsSinceByAuthors(repo, since, authorNames)
-		if err != nil {
-			errors = append(errors, fmt.Sprintf("Failed to load commits from %s: %v", repo, err))
-			continue
-		}
-
-		for _, commit := range commits {
-			key := repo + ":" + commit.Hash
-			allCommits[key] = commit
-		}
-	}
-
-	result := make([]Commit, 0, len(allCommits))
-	for _, commit := range allCommits {
-		result = append(result, commit)
+	gitConfig, err := LoadGitConfig()
+	if err != nil {
+		gitConfig = &GitConfig{}
 	}
 
-	sort.Slice(result, func(i, j int) bool {
-		return result[i].Timestamp.After(result[j].Timestamp)
-	})
-
-	if len(errors) > 0 && len(result) == 0 {
-		return nil, fmt.Errorf("all repositories failed to load commits: %s", strings.Join(errors, "; "))
+	if query.Aliases == nil {
+		query.Aliases = gitConfig.AuthorAliases
 	}
 
-	return result, nil
-}
+	ctx = withRequestLimit(ctx, gitConfig.concurrencyLimit())
 
-func GetCommitsSinceByBranches(repoPathOrURL string, since time.Time, branchNames []string) ([]Commit, error) {
-	var commits []Commit
-	var err error
-
-	if isRemoteURL(repoPathOrURL) {
-		provider, err := detectProvider(repoPathOrURL)
-		if err != nil {
-			return nil, fmt.Errorf("failed to detect Git provider: %w", err)
-		}
-		commits, err = provider.GetCommitsSinceByBranches(repoPathOrURL, since, branchNames)
-	} else {
-		commits, err = getCommitsSinceByBranchesLocal(repoPathOrURL, since, branchNames)
-	}
+	repoCommits := make([][]Commit, len(repoPathsOrURLs))
+	repoErrors := make([]error, len(repoPathsOrURLs))
 
+	err = runLimited(ctx, gitConfig.concurrencyLimit(), len(repoPathsOrURLs), func(ctx context.Context, i int) error {
+		repoCommits[i], repoErrors[i] = getRepoCommits(ctx, repoPathsOrURLs[i], query)
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	repoName := getRepoDisplayName(repoPathOrURL)
-	repoType := "local"
-	if isRemoteURL(repoPathOrURL) {
-		repoType = "remote"
-	}
-
-	for i := range commits {
-		commits[i].RepoName = repoName
-		commits[i].RepoType = repoType
-	}
+	truncated := &TruncatedError{}
+	seen := make(map[string]bool)
 
-	return commits, nil
-}
-
-func GetCommitsSinceByBranchesFromMultipleRepos(repoPathsOrURLs []string, since time.Time, branchNames []string) ([]Commit, error) {
-	if len(repoPathsOrURLs) == 0 {
-		return []Commit{}, nil
-	}
-
-	allCommits := make(map[string]Commit)
-	var errors []string
-
-	for _, repo := range repoPathsOrURLs {
-		commits, err := GetCommitsSinceByBranches(repo, since, branchNames)
-		if err != nil {
-			errors = append(errors, fmt.Sprintf("Failed to load commits from %s: %v", repo, err))
+	for i, repo := range repoPathsOrURLs {
+		err := repoErrors[i]
+		branchErrors, partial := partialErrors(err)
+		if !partial {
+			result.Failures = append(result.Failures, SourceError{Source: repo, Err: err})
 			continue
 		}
+		mergeTruncation(truncated, err)
+		for _, branchErr := range branchErrors {
+			result.Failures = append(result.Failures, SourceError{Source: repo, Err: branchErr})
+		}
 
-		for _, commit := range commits {
+		for _, commit := range repoCommits[i] {
 			key := repo + ":" + commit.Hash
-			allCommits[key] = commit
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			result.Commits = append(result.Commits, commit)
 		}
 	}
 
-	result := make([]Commit, 0, len(allCommits))
-	for _, commit := range allCommits {
-		result = append(result, commit)
-	}
-
-	sort.Slice(result, func(i, j int) bool {
-		return result[i].Timestamp.After(result[j].Timestamp)
-	})
+	sortCommitsByTimestamp(result.Commits)
 
-	if len(errors) > 0 && len(result) == 0 {
-		return nil, fmt.Errorf("all repositories failed to load commits: %s", strings.Join(errors, "; "))
+	if len(truncated.Sources) > 0 {
+		result.Truncated = truncated
 	}
 
 	return result, nil
 }
 
-func GetCommitsSinceByAuthorsAndBranches(repoPathOrURL string, since time.Time, authorNames []string, branchNames []string) ([]Commit, error) {
+func getRepoCommits(ctx context.Context, repoPathOrURL string, query CommitQuery) ([]Commit, error) {
 	var commits []Commit
 	var err error
 
 	if isRemoteURL(repoPathOrURL) {
-		provider, err := detectProvider(repoPathOrURL)
-		if err != nil {
-			return nil, fmt.Errorf("failed to detect Git provider: %w", err)
+		provider, providerErr := detectProvider(repoPathOrURL)
+		if providerErr != nil {
+			return nil, fmt.Errorf("failed to detect Git provider: %w", providerErr)
 		}
-		commits, err = provider.GetCommitsSinceByAuthorsAndBranches(repoPathOrURL, since, authorNames, branchNames)
+		commits, err = provider.Query(ctx, repoPathOrURL, query)
 	} else {
-		commits, err = getCommitsSinceByAuthorsAndBranchesLocal(repoPathOrURL, since, authorNames, branchNames)
+		commits, err = getCommitsLocal(ctx, repoPathOrURL, query)
 	}
 
-	if err != nil {
+	if _, partial := partialErrors(err); !partial {
 		return nil, err
 	}
 
@@ -227,406 +190,45 @@ func GetCommitsSinceByAuthorsAndBranches(repoPathOrURL string, since time.Time,
 	for i := range commits {
 		commits[i].RepoName = repoName
 		commits[i].RepoType = repoType
+		commits[i].Source = repoPathOrURL
 	}
 
-	return commits, nil
-}
-
-func GetCommitsSinceByAuthorsAndBranchesFromMultipleRepos(repoPathsOrURLs []string, since time.Time, authorNames []string, branchNames []string) ([]Commit, error) {
-	if len(repoPathsOrURLs) == 0 {
-		return []Commit{}, nil
-	}
-
-	allCommits := make(map[string]Commit)
-	var errors []string
-
-	for _, repo := range repoPathsOrURLs {
-		commits, err := GetCommitsSinceByAuthorsAndBranches(repo, since, authorNames, branchNames)
-		if err != nil {
-			errors = append(errors, fmt.Sprintf("Failed to load commits from %s: %v", repo, err))
-			continue
-		}
-
-		for _, commit := range commits {
-			key := repo + ":" + commit.Hash
-			allCommits[key] = commit
-		}
-	}
-
-	result := make([]Commit, 0, len(allCommits))
-	for _, commit := range allCommits {
-		result = append(result, commit)
-	}
-
-	sort.Slice(result, func(i, j int) bool {
-		return result[i].Timestamp.After(result[j].Timestamp)
-	})
-
-	if len(errors) > 0 && len(result) == 0 {
-		return nil, fmt.Errorf("all repositories failed to load commits: %s", strings.Join(errors, "; "))
-	}
-
-	return result, nil
-}
-
-func getCommitsSinceLocal(repoPath string, since time.Time) ([]Commit, error) {
-	since = helpers.ToMidnight(since)
-
-	repo, err := git.PlainOpen(repoPath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to open repository: %w", err)
-	}
-
-	refs, err := repo.References()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get references: %w", err)
-	}
-
-	uniqueCommits := make(map[string]Commit)
-
-	err = refs.ForEach(func(ref *plumbing.Reference) error {
-		if ref.Name() == plumbing.HEAD {
-			return nil
-		}
-
-		commitIter, err := repo.Log(&git.LogOptions{From: ref.Hash()})
-		if err != nil {
-			return nil
-		}
-		defer commitIter.Close()
-
-		err = commitIter.ForEach(func(c *object.Commit) error {
-			if c.Author.When.UTC().After(since) {
-				hash := c.Hash.String()
-				if _, exists := uniqueCommits[hash]; !exists {
-					uniqueCommits[hash] = Commit{
-						Hash:      hash,
-						Branch:    ref.Name().Short(),
-						Author:    c.Author.Name,
-						Message:   c.Message,
-						Timestamp: c.Author.When,
-					}
-				}
-			}
-			return nil
-		})
-		if err != nil {
-			return err
-		}
-		return nil
-	})
-
-	if err != nil {
-		return nil, fmt.Errorf("error iterating references: %w", err)
-	}
-
-	commits := make([]Commit, 0, len(uniqueCommits))
-	for _, commit := range uniqueCommits {
-		commits = append(commits, commit)
-	}
-
-	sort.Slice(commits, func(i, j int) bool {
-		return commits[i].Timestamp.After(commits[j].Timestamp)
-	})
-
-	return commits, nil
-}
-
-func getCommitsSinceByAuthorsLocal(repoPath string, since time.Time, authorNames []string) ([]Commit, error) {
-	since = helpers.ToMidnight(since)
-
-	repo, err := git.PlainOpen(repoPath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to open repository: %w", err)
-	}
-
-	refs, err := repo.References()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get references: %w", err)
-	}
-
-	uniqueCommits := make(map[string]Commit)
-
-	var lowerAuthorNames []string
-	for _, name := range authorNames {
-		lowerAuthorNames = append(lowerAuthorNames, strings.ToLower(name))
-	}
-
-	err = refs.ForEach(func(ref *plumbing.Reference) error {
-		if ref.Name() == plumbing.HEAD {
-			return nil
-		}
-
-		commitIter, err := repo.Log(&git.LogOptions{From: ref.Hash()})
-		if err != nil {
-			return nil
-		}
-		defer commitIter.Close()
-
-		err = commitIter.ForEach(func(c *object.Commit) error {
-			if c.Author.When.UTC().After(since) {
-				authorLower := strings.ToLower(c.Author.Name)
-				matchesAuthor := false
-
-				for _, targetAuthor := range lowerAuthorNames {
-					if strings.Contains(authorLower, targetAuthor) || strings.Contains(targetAuthor, authorLower) {
-						matchesAuthor = true
-						break
-					}
-				}
-
-				if matchesAuthor {
-					hash := c.Hash.String()
-					if _, exists := uniqueCommits[hash]; !exists {
-						uniqueCommits[hash] = Commit{
-							Hash:      hash,
-							Branch:    ref.Name().Short(),
-							Author:    c.Author.Name,
-							Message:   c.Message,
-							Timestamp: c.Author.When,
-						}
-					}
-				}
-			}
-			return nil
-		})
-		if err != nil {
-			return err
-		}
-		return nil
-	})
-
-	if err != nil {
-		return nil, fmt.Errorf("error iterating references: %w", err)
-	}
-
-	commits := make([]Commit, 0, len(uniqueCommits))
-	for _, commit := range uniqueCommits {
-		commits = append(commits, commit)
-	}
-
-	sort.Slice(commits, func(i, j int) bool {
-		return commits[i].Timestamp.After(commits[j].Timestamp)
-	})
-
-	return commits, nil
-}
-
-func getCommitsSinceByBranchesLocal(repoPath string, since time.Time, branchNames []string) ([]Commit, error) {
-	since = helpers.ToMidnight(since)
-
-	repo, err := git.PlainOpen(repoPath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to open repository: %w", err)
-	}
-
-	refs, err := repo.References()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get references: %w", err)
-	}
-
-	uniqueCommits := make(map[string]Commit)
-
-	var lowerBranchNames []string
-	for _, name := range branchNames {
-		lowerBranchNames = append(lowerBranchNames, strings.ToLower(name))
-	}
-
-	err = refs.ForEach(func(ref *plumbing.Reference) error {
-		if ref.Name() == plumbing.HEAD {
-			return nil
-		}
-
-		branchLower := strings.ToLower(ref.Name().Short())
-		matchesBranch := false
-		for _, targetBranch := range lowerBranchNames {
-			if strings.Contains(branchLower, targetBranch) {
-				matchesBranch = true
-				break
-			}
-		}
-
-		if !matchesBranch {
-			return nil
-		}
-
-		commitIter, err := repo.Log(&git.LogOptions{From: ref.Hash()})
-		if err != nil {
-			return nil
-		}
-		defer commitIter.Close()
-
-		err = commitIter.ForEach(func(c *object.Commit) error {
-			if c.Author.When.UTC().After(since) {
-				hash := c.Hash.String()
-				if _, exists := uniqueCommits[hash]; !exists {
-					uniqueCommits[hash] = Commit{
-						Hash:      hash,
-						Branch:    ref.Name().Short(),
-						Author:    c.Author.Name,
-						Message:   c.Message,
-						Timestamp: c.Author.When,
-					}
-				}
-			}
-			return nil
-		})
-		if err != nil {
-			return err
-		}
-		return nil
-	})
-
-	if err != nil {
-		return nil, fmt.Errorf("error iterating references: %w", err)
-	}
-
-	commits := make([]Commit, 0, len(uniqueCommits))
-	for _, commit := range uniqueCommits {
-		commits = append(commits, commit)
-	}
-
-	sort.Slice(commits, func(i, j int) bool {
-		return commits[i].Timestamp.After(commits[j].Timestamp)
-	})
-
-	return commits, nil
+	return commits, err
 }
 
-func getCommitsSinceByAuthorsAndBranchesLocal(repoPath string, since time.Time, authorNames []string, branchNames []string) ([]Commit, error) {
-	since = helpers.ToMidnight(since)
-
-	repo, err := git.PlainOpen(repoPath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to open repository: %w", err)
+func partialErrors(err error) ([]*BranchError, bool) {
+	if err == nil {
+		return nil, true
 	}
 
-	refs, err := repo.References()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get references: %w", err)
+	errs := []error{err}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		errs = joined.Unwrap()
 	}
 
-	uniqueCommits := make(map[string]Commit)
-
-	var lowerAuthorNames []string
-	for _, name := range authorNames {
-		lowerAuthorNames = append(lowerAuthorNames, strings.ToLower(name))
-	}
-
-	var lowerBranchNames []string
-	for _, name := range branchNames {
-		lowerBranchNames = append(lowerBranchNames, strings.ToLower(name))
-	}
-
-	err = refs.ForEach(func(ref *plumbing.Reference) error {
-		if ref.Name() == plumbing.HEAD {
-			return nil
-		}
-
-		branchLower := strings.ToLower(ref.Name().Short())
-		matchesBranch := false
-		for _, targetBranch := range lowerBranchNames {
-			if strings.Contains(branchLower, targetBranch) {
-				matchesBranch = true
-				break
-			}
-		}
-
-		if !matchesBranch {
-			return nil
-		}
-
-		commitIter, err := repo.Log(&git.LogOptions{From: ref.Hash()})
-		if err != nil {
-			return nil
-		}
-		defer commitIter.Close()
-
-		err = commitIter.ForEach(func(c *object.Commit) error {
-			if c.Author.When.UTC().After(since) {
-
-				authorLower := strings.ToLower(c.Author.Name)
-				matchesAuthor := false
-
-				for _, targetAuthor := range lowerAuthorNames {
-					if strings.Contains(authorLower, targetAuthor) || strings.Contains(targetAuthor, authorLower) {
-						matchesAuthor = true
-						break
-					}
-				}
-
-				if matchesAuthor {
-					hash := c.Hash.String()
-					if _, exists := uniqueCommits[hash]; !exists {
-						uniqueCommits[hash] = Commit{
-							Hash:      hash,
-							Branch:    ref.Name().Short(),
-							Author:    c.Author.Name,
-							Message:   c.Message,
-							Timestamp: c.Author.When,
-						}
-					}
-				}
-			}
-			return nil
-		})
-		if err != nil {
-			return err
+	var branchErrors []*BranchError
+	for _, err := range errs {
+		var branchErr *BranchError
+		switch {
+		case errors.As(err, &branchErr):
+			branchErrors = append(branchErrors, branchErr)
+		case IsTruncated(err):
+		default:
+			return nil, false
 		}
-		return nil
-	})
-
-	if err != nil {
-		return nil, fmt.Errorf("error iterating references: %w", err)
-	}
-
-	commits := make([]Commit, 0, len(uniqueCommits))
-	for _, commit := range uniqueCommits {
-		commits = append(commits, commit)
 	}
-
-	sort.Slice(commits, func(i, j int) bool {
-		return commits[i].Timestamp.After(commits[j].Timestamp)
-	})
-
-	return commits, nil
+	return branchErrors, true
 }
 
-func GetCommitsSinceFromMultipleRepos(repoPathsOrURLs []string, since time.Time) ([]Commit, error) {
-	if len(repoPathsOrURLs) == 0 {
-		return []Commit{}, nil
-	}
-
-	allCommits := make(map[string]Commit)
-	var errors []string
-
-	for _, repo := range repoPathsOrURLs {
-		commits, err := GetCommitsSince(repo, since)
-		if err != nil {
-			errors = append(errors, fmt.Sprintf("Failed to load commits from %s: %v", repo, err))
-			continue
-		}
-
-		for _, commit := range commits {
-			key := repo + ":" + commit.Hash
-			allCommits[key] = commit
-		}
+func mergeTruncation(into *TruncatedError, err error) {
+	var truncated *TruncatedError
+	if !errors.As(err, &truncated) {
+		return
 	}
-
-	result := make([]Commit, 0, len(allCommits))
-	for _, commit := range allCommits {
-		result = append(result, commit)
+	into.Sources = append(into.Sources, truncated.Sources...)
+	if truncated.MaxPages > into.MaxPages {
+		into.MaxPages = truncated.MaxPages
 	}
-
-	sort.Slice(result, func(i, j int) bool {
-		return result[i].Timestamp.After(result[j].Timestamp)
-	})
-
-	if len(errors) > 0 && len(result) == 0 {
-		return nil, fmt.Errorf("all repositories failed to load commits: %s", strings.Join(errors, "; "))
-	}
-
-	return result, nil
 }
 
 func getRepoDisplayName(repoPathOrURL string) string {
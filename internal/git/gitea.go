@@ -0,0 +1,267 @@
+package git
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type GiteaProvider struct {
+	client      *http.Client
+	token       string
+	baseURL     string
+	concurrency int
+}
+
+type GiteaCommit struct {
+	SHA    string `json:"sha"`
+	Commit struct {
+		Author struct {
+			Name  string    `json:"name"`
+			Email string    `json:"email"`
+			Date  time.Time `json:"date"`
+		} `json:"author"`
+		Committer struct {
+			Name  string    `json:"name"`
+			Email string    `json:"email"`
+			Date  time.Time `json:"date"`
+		} `json:"committer"`
+		Message string `json:"message"`
+	} `json:"commit"`
+	Parents []struct {
+		SHA string `json:"sha"`
+	} `json:"parents"`
+}
+
+type GiteaBranch struct {
+	Name   string `json:"name"`
+	Commit struct {
+		ID string `json:"id"`
+	} `json:"commit"`
+}
+
+const giteaPageSize = 50
+
+func NewGiteaProvider() *GiteaProvider {
+	return &GiteaProvider{
+		client:      &http.Client{Timeout: 30 * time.Second},
+		concurrency: DefaultConcurrency,
+	}
+}
+
+func (g *GiteaProvider) SetConcurrency(concurrency int) {
+	if concurrency > 0 {
+		g.concurrency = concurrency
+	}
+}
+
+func (g *GiteaProvider) SetToken(token string) {
+	g.token = token
+}
+
+func (g *GiteaProvider) SetBaseURL(baseURL string) {
+	g.baseURL = strings.TrimSuffix(baseURL, "/")
+}
+
+func (g *GiteaProvider) parseGiteaURL(repoURL string) (apiBase, owner, repo string, err error) {
+	host := getRepoHost(repoURL)
+	parts := strings.Split(getRepoPath(repoURL), "/")
+	if host == "" || len(parts) < 2 {
+		return "", "", "", fmt.Errorf("invalid Gitea URL format: %s", repoURL)
+	}
+
+	owner, repo = parts[len(parts)-2], parts[len(parts)-1]
+
+	if g.baseURL != "" {
+		return g.baseURL, owner, repo, nil
+	}
+
+	scheme := "https"
+	if strings.HasPrefix(repoURL, "http://") {
+		scheme = "http"
+	}
+
+	contextPath := strings.Join(parts[:len(parts)-2], "/")
+	if contextPath != "" {
+		return fmt.Sprintf("%s://%s/%s/api/v1", scheme, host, contextPath), owner, repo, nil
+	}
+	return fmt.Sprintf("%s://%s/api/v1", scheme, host), owner, repo, nil
+}
+
+func (g *GiteaProvider) makeRequest(ctx context.Context, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	if g.token != "" {
+		req.Header.Set("Authorization", "token "+g.token)
+	}
+
+	resp, err := doLimited(g.client, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make HTTP request: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (g *GiteaProvider) getPaged(ctx context.Context, baseURL string, params url.Values, out func(resp *http.Response) (int, error)) error {
+	page := 1
+	for {
+		params.Set("limit", strconv.Itoa(giteaPageSize))
+		params.Set("page", strconv.Itoa(page))
+
+		resp, err := g.makeRequest(ctx, baseURL+"?"+params.Encode())
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return fmt.Errorf("Gitea API error: HTTP %d", resp.StatusCode)
+		}
+
+		count, err := out(resp)
+		hasMore := resp.Header.Get("X-HasMore")
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		if hasMore == "false" || (hasMore == "" && count < giteaPageSize) || count == 0 {
+			return nil
+		}
+		page++
+	}
+}
+
+func (g *GiteaProvider) getBranches(ctx context.Context, apiBase, owner, repo string) ([]GiteaBranch, error) {
+	endpoint := fmt.Sprintf("%s/repos/%s/%s/branches", apiBase, url.PathEscape(owner), url.PathEscape(repo))
+
+	var branches []GiteaBranch
+	err := g.getPaged(ctx, endpoint, url.Values{}, func(resp *http.Response) (int, error) {
+		var page []GiteaBranch
+		if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+			return 0, fmt.Errorf("failed to decode branches response for %s/%s: %w", owner, repo, err)
+		}
+		branches = append(branches, page...)
+		return len(page), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get branches for %s/%s: %w", owner, repo, err)
+	}
+
+	return branches, nil
+}
+
+func (g *GiteaProvider) getCommitsFromBranch(ctx context.Context, apiBase, owner, repo string, branch GiteaBranch, path string, since, until time.Time) ([]Commit, error) {
+	endpoint := fmt.Sprintf("%s/repos/%s/%s/commits", apiBase, url.PathEscape(owner), url.PathEscape(repo))
+	params := url.Values{}
+	params.Set("sha", branch.Name)
+	if path != "" {
+		params.Set("path", path)
+	}
+	params.Set("since", since.UTC().Format(time.RFC3339))
+	if !until.IsZero() {
+		params.Set("until", until.UTC().Format(time.RFC3339))
+	}
+	params.Set("stat", "false")
+	params.Set("verification", "false")
+	params.Set("files", "false")
+
+	var commits []Commit
+	err := g.getPaged(ctx, endpoint, params, func(resp *http.Response) (int, error) {
+		var page []GiteaCommit
+		if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+			return 0, fmt.Errorf("failed to decode commits response for %s/%s branch %s: %w", owner, repo, branch.Name, err)
+		}
+		for _, gc := range page {
+			var parents []string
+			for _, parent := range gc.Parents {
+				parents = append(parents, parent.SHA)
+			}
+
+			commits = append(commits, Commit{
+				Hash:        gc.SHA,
+				Branch:      branch.Name,
+				Author:      gc.Commit.Author.Name,
+				AuthorEmail: gc.Commit.Author.Email,
+				Message:     gc.Commit.Message,
+				Timestamp:   gc.Commit.Author.Date,
+
+				Committer:      gc.Commit.Committer.Name,
+				CommitterEmail: gc.Commit.Committer.Email,
+				CommitTime:     gc.Commit.Committer.Date,
+
+				ParentCount: len(gc.Parents),
+				Parents:     parents,
+			})
+		}
+		return len(page), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commits for %s/%s branch %s: %w", owner, repo, branch.Name, err)
+	}
+
+	return commits, nil
+}
+
+func (g *GiteaProvider) getCommits(ctx context.Context, repoURL string, since, until time.Time, branchNames, pathPatterns []string) ([]Commit, error) {
+	apiBase, owner, repo, err := g.parseGiteaURL(repoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	branches, err := g.getBranches(ctx, apiBase, owner, repo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get branches: %w", err)
+	}
+
+	var matched []GiteaBranch
+	for _, branch := range branches {
+		if branchMatches(branch.Name, branchNames) {
+			matched = append(matched, branch)
+		}
+	}
+
+	paths := pathParams(pathPatterns)
+
+	branchCommits := make([][]Commit, len(matched)*len(paths))
+	branchErrors := make([]error, len(branchCommits))
+	err = runLimited(ctx, g.concurrency, len(branchCommits), func(ctx context.Context, i int) error {
+		branch := matched[i/len(paths)]
+		commits, err := g.getCommitsFromBranch(ctx, apiBase, owner, repo, branch, paths[i%len(paths)], since, until)
+		if err != nil {
+			branchErrors[i] = &BranchError{Branch: branch.Name, Err: err}
+			return nil
+		}
+		branchCommits[i] = commits
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mergeBranchCommits(branchCommits), errors.Join(branchErrors...)
+}
+
+func (g *GiteaProvider) Query(ctx context.Context, repoURL string, query CommitQuery) ([]Commit, error) {
+	if hasPathGlobs(query.Paths) {
+		return nil, unsupportedPathGlobs("Gitea")
+	}
+
+	commits, err := g.getCommits(ctx, repoURL, query.Since, query.Until, query.Branches, query.Paths)
+	if _, partial := partialErrors(err); !partial {
+		return nil, err
+	}
+
+	return applyCommitQuery(commits, query), err
+}
@@ -1,29 +1,51 @@
 package git
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
-	"regexp"
+	"slices"
 	"strings"
 	"time"
 )
 
 type GitHubProvider struct {
-	client *http.Client
-	token  string
+	client      *http.Client
+	token       string
+	maxPages    int
+	baseURL     string
+	concurrency int
 }
 
 type GitHubCommit struct {
 	SHA    string `json:"sha"`
 	Commit struct {
 		Author struct {
-			Name string    `json:"name"`
-			Date time.Time `json:"date"`
+			Name  string    `json:"name"`
+			Email string    `json:"email"`
+			Date  time.Time `json:"date"`
 		} `json:"author"`
+		Committer struct {
+			Name  string    `json:"name"`
+			Email string    `json:"email"`
+			Date  time.Time `json:"date"`
+		} `json:"committer"`
 		Message string `json:"message"`
 	} `json:"commit"`
+	Parents []struct {
+		SHA string `json:"sha"`
+	} `json:"parents"`
+}
+
+type GitHubCommitDetail struct {
+	Files []struct {
+		Filename  string `json:"filename"`
+		Additions int    `json:"additions"`
+		Deletions int    `json:"deletions"`
+	} `json:"files"`
 }
 
 type GitHubBranch struct {
@@ -33,9 +55,13 @@ type GitHubBranch struct {
 	} `json:"commit"`
 }
 
+const DefaultMaxPages = 10
+
 func NewGitHubProvider() *GitHubProvider {
 	return &GitHubProvider{
-		client: &http.Client{Timeout: 30 * time.Second},
+		client:      &http.Client{Transport: newCacheTransport(newRateLimitTransport())},
+		maxPages:    DefaultMaxPages,
+		concurrency: DefaultConcurrency,
 	}
 }
 
@@ -43,25 +69,49 @@ func (g *GitHubProvider) SetToken(token string) {
 	g.token = token
 }
 
-func (g *GitHubProvider) parseGitHubURL(repoURL string) (owner, repo string, err error) {
-	patterns := []string{
-		`github\.com[:/]([^/]+)/([^/]+?)(?:\.git)?/?$`,
-		`github\.com/([^/]+)/([^/]+?)(?:\.git)?/?$`,
+func (g *GitHubProvider) SetConcurrency(concurrency int) {
+	if concurrency > 0 {
+		g.concurrency = concurrency
 	}
+}
 
-	for _, pattern := range patterns {
-		re := regexp.MustCompile(pattern)
-		matches := re.FindStringSubmatch(repoURL)
-		if len(matches) >= 3 {
-			return matches[1], matches[2], nil
-		}
+func (g *GitHubProvider) SetMaxPages(maxPages int) {
+	if maxPages > 0 {
+		g.maxPages = maxPages
+	}
+}
+
+func (g *GitHubProvider) SetBaseURL(baseURL string) {
+	g.baseURL = strings.TrimSuffix(baseURL, "/")
+}
+
+func (g *GitHubProvider) parseGitHubURL(repoURL string) (apiBase, owner, repo string, err error) {
+	host := getRepoHost(repoURL)
+	parts := strings.Split(getRepoPath(repoURL), "/")
+	if host == "" || len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", "", fmt.Errorf("invalid GitHub URL format: %s", repoURL)
+	}
+
+	owner, repo = parts[0], parts[1]
+
+	if g.baseURL != "" {
+		return g.baseURL, owner, repo, nil
 	}
 
-	return "", "", fmt.Errorf("invalid GitHub URL format: %s", repoURL)
+	if host == "github.com" || host == "www.github.com" {
+		return "https://" + gitHubAPIHost, owner, repo, nil
+	}
+
+	scheme := "https"
+	if strings.HasPrefix(repoURL, "http://") {
+		scheme = "http"
+	}
+
+	return fmt.Sprintf("%s://%s/api/v3", scheme, host), owner, repo, nil
 }
 
-func (g *GitHubProvider) makeRequest(url string) (*http.Response, error) {
-	req, err := http.NewRequest("GET", url, nil)
+func (g *GitHubProvider) makeRequest(ctx context.Context, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -71,7 +121,7 @@ func (g *GitHubProvider) makeRequest(url string) (*http.Response, error) {
 		req.Header.Set("Authorization", "token "+g.token)
 	}
 
-	resp, err := g.client.Do(req)
+	resp, err := doLimited(g.client, req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make HTTP request: %w", err)
 	}
@@ -79,216 +129,258 @@ func (g *GitHubProvider) makeRequest(url string) (*http.Response, error) {
 	return resp, nil
 }
 
-func (g *GitHubProvider) GetCommitsSince(repoURL string, since time.Time) ([]Commit, error) {
-	owner, repo, err := g.parseGitHubURL(repoURL)
+func (g *GitHubProvider) Query(ctx context.Context, repoURL string, query CommitQuery) ([]Commit, error) {
+	apiBase, owner, repo, err := g.parseGitHubURL(repoURL)
 	if err != nil {
 		return nil, err
 	}
 
-	branches, err := g.getBranches(owner, repo)
+	branches, branchesTruncated, err := g.getBranches(ctx, apiBase, owner, repo)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get branches: %w", err)
 	}
 
-	uniqueCommits := make(map[string]Commit)
+	var truncatedSources []string
+	if branchesTruncated {
+		truncatedSources = append(truncatedSources, fmt.Sprintf("%s/%s branches", owner, repo))
+	}
 
+	var matched []GitHubBranch
 	for _, branch := range branches {
-		commits, err := g.getCommitsFromBranch(owner, repo, branch, since)
-		if err != nil {
-			continue
-		}
-
-		for _, commit := range commits {
-			if _, exists := uniqueCommits[commit.Hash]; !exists {
-				uniqueCommits[commit.Hash] = commit
-			}
+		if branchMatches(branch.Name, query.Branches) {
+			matched = append(matched, branch)
 		}
 	}
 
-	result := make([]Commit, 0, len(uniqueCommits))
-	for _, commit := range uniqueCommits {
-		result = append(result, commit)
-	}
+	paths := pathParams(query.Paths)
 
-	for i := 0; i < len(result)-1; i++ {
-		for j := i + 1; j < len(result); j++ {
-			if result[i].Timestamp.Before(result[j].Timestamp) {
-				result[i], result[j] = result[j], result[i]
-			}
+	branchCommits := make([][]Commit, len(matched)*len(paths))
+	branchTruncated := make([]bool, len(branchCommits))
+	branchErrors := make([]error, len(branchCommits))
+	err = runLimited(ctx, g.concurrency, len(branchCommits), func(ctx context.Context, i int) error {
+		branchIndex := i / len(paths)
+		commits, commitsTruncated, err := g.getCommitsFromBranch(ctx, apiBase, owner, repo, matched[branchIndex], paths[i%len(paths)], query.Since, query.Until)
+		if IsRateLimited(err) {
+			return err
 		}
-	}
-
-	return result, nil
-}
-
-func (g *GitHubProvider) GetCommitsSinceByAuthors(repoURL string, since time.Time, authorNames []string) ([]Commit, error) {
-	commits, err := g.GetCommitsSince(repoURL, since)
+		if err != nil {
+			branchErrors[i] = &BranchError{Branch: matched[branchIndex].Name, Err: err}
+			return nil
+		}
+		branchCommits[i] = commits
+		branchTruncated[i] = commitsTruncated
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	var lowerAuthorNames []string
-	for _, name := range authorNames {
-		lowerAuthorNames = append(lowerAuthorNames, strings.ToLower(name))
+	for i, branch := range matched {
+		if slices.Contains(branchTruncated[i*len(paths):(i+1)*len(paths)], true) {
+			truncatedSources = append(truncatedSources, fmt.Sprintf("%s/%s@%s", owner, repo, branch.Name))
+		}
 	}
 
-	var filtered []Commit
-	for _, commit := range commits {
-		authorLower := strings.ToLower(commit.Author)
-		matchesAuthor := false
+	result := applyCommitQuery(mergeBranchCommits(branchCommits), query)
 
-		for _, targetAuthor := range lowerAuthorNames {
-			if strings.Contains(authorLower, targetAuthor) || strings.Contains(targetAuthor, authorLower) {
-				matchesAuthor = true
-				break
-			}
+	if hasPathGlobs(query.Paths) {
+		result, err = g.filterCommitsByPaths(ctx, apiBase, owner, repo, result, newPathFilter(query.Paths))
+		if err != nil {
+			return nil, err
 		}
+	}
 
-		if matchesAuthor {
-			filtered = append(filtered, commit)
-		}
+	if len(truncatedSources) > 0 {
+		branchErrors = append(branchErrors, &TruncatedError{Sources: truncatedSources, MaxPages: g.maxPages})
 	}
 
-	return filtered, nil
+	return result, errors.Join(branchErrors...)
 }
 
-func (g *GitHubProvider) GetCommitsSinceByBranches(repoURL string, since time.Time, branchNames []string) ([]Commit, error) {
-	commits, err := g.GetCommitsSince(repoURL, since)
+func (g *GitHubProvider) getCommitDetail(ctx context.Context, apiBase, owner, repo, sha string) (*GitHubCommitDetail, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/commits/%s", apiBase, owner, repo, sha)
+
+	resp, err := g.makeRequest(ctx, url)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
-	var lowerBranchNames []string
-	for _, name := range branchNames {
-		lowerBranchNames = append(lowerBranchNames, strings.ToLower(name))
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GitHub API error for %s/%s commit %s: HTTP %d", owner, repo, sha, resp.StatusCode)
 	}
 
-	var filtered []Commit
-	for _, commit := range commits {
-		branchLower := strings.ToLower(commit.Branch)
-		matchesBranch := false
-
-		for _, targetBranch := range lowerBranchNames {
-			if strings.Contains(branchLower, targetBranch) {
-				matchesBranch = true
-				break
-			}
-		}
-
-		if matchesBranch {
-			filtered = append(filtered, commit)
-		}
+	var detail GitHubCommitDetail
+	if err := json.NewDecoder(resp.Body).Decode(&detail); err != nil {
+		return nil, fmt.Errorf("failed to decode commit response for %s/%s commit %s: %w", owner, repo, sha, err)
 	}
 
-	return filtered, nil
+	return &detail, nil
 }
 
-func (g *GitHubProvider) GetCommitsSinceByAuthorsAndBranches(repoURL string, since time.Time, authorNames []string, branchNames []string) ([]Commit, error) {
-	commits, err := g.GetCommitsSince(repoURL, since)
+func (g *GitHubProvider) CommitFiles(ctx context.Context, repoURL, hash string) ([]FileChange, error) {
+	apiBase, owner, repo, err := g.parseGitHubURL(repoURL)
 	if err != nil {
 		return nil, err
 	}
 
-	var lowerAuthorNames []string
-	for _, name := range authorNames {
-		lowerAuthorNames = append(lowerAuthorNames, strings.ToLower(name))
+	detail, err := g.getCommitDetail(ctx, apiBase, owner, repo, hash)
+	if err != nil {
+		return nil, err
 	}
 
-	var lowerBranchNames []string
-	for _, name := range branchNames {
-		lowerBranchNames = append(lowerBranchNames, strings.ToLower(name))
+	files := make([]FileChange, len(detail.Files))
+	for i, file := range detail.Files {
+		files[i] = FileChange{Path: file.Filename, Additions: file.Additions, Deletions: file.Deletions}
 	}
 
-	var filtered []Commit
-	for _, commit := range commits {
-
-		branchLower := strings.ToLower(commit.Branch)
-		matchesBranch := false
-		for _, targetBranch := range lowerBranchNames {
-			if strings.Contains(branchLower, targetBranch) {
-				matchesBranch = true
-				break
-			}
-		}
+	return files, nil
+}
 
-		if !matchesBranch {
-			continue
+func (g *GitHubProvider) filterCommitsByPaths(ctx context.Context, apiBase, owner, repo string, commits []Commit, pathFilter func(string) bool) ([]Commit, error) {
+	keep := make([]bool, len(commits))
+	err := runLimited(ctx, g.concurrency, len(commits), func(ctx context.Context, i int) error {
+		detail, err := g.getCommitDetail(ctx, apiBase, owner, repo, commits[i].Hash)
+		if err != nil {
+			return err
 		}
 
-		authorLower := strings.ToLower(commit.Author)
-		matchesAuthor := false
-		for _, targetAuthor := range lowerAuthorNames {
-			if strings.Contains(authorLower, targetAuthor) || strings.Contains(targetAuthor, authorLower) {
-				matchesAuthor = true
+		for _, file := range detail.Files {
+			if pathFilter(file.Filename) {
+				keep[i] = true
 				break
 			}
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		if matchesAuthor {
+	var filtered []Commit
+	for i, commit := range commits {
+		if keep[i] {
 			filtered = append(filtered, commit)
 		}
 	}
-
 	return filtered, nil
 }
 
-func (g *GitHubProvider) getBranches(owner, repo string) ([]GitHubBranch, error) {
-	url := fmt.Sprintf("https://api.github.com/repos/%s/%s/branches", owner, repo)
+func (g *GitHubProvider) getBranches(ctx context.Context, apiBase, owner, repo string) ([]GitHubBranch, bool, error) {
+	nextURL := fmt.Sprintf("%s/repos/%s/%s/branches?per_page=100", apiBase, owner, repo)
 
-	resp, err := g.makeRequest(url)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get branches for %s/%s: %w", owner, repo, err)
-	}
-	defer resp.Body.Close()
+	var branches []GitHubBranch
+	for page := 0; nextURL != ""; page++ {
+		if page >= g.maxPages {
+			return branches, true, nil
+		}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("GitHub API error for %s/%s branches: HTTP %d", owner, repo, resp.StatusCode)
-	}
+		resp, err := g.makeRequest(ctx, nextURL)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to get branches for %s/%s: %w", owner, repo, err)
+		}
 
-	var branches []GitHubBranch
-	if err := json.NewDecoder(resp.Body).Decode(&branches); err != nil {
-		return nil, fmt.Errorf("failed to decode branches response for %s/%s: %w", owner, repo, err)
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, false, fmt.Errorf("GitHub API error for %s/%s branches: HTTP %d", owner, repo, resp.StatusCode)
+		}
+
+		var pageBranches []GitHubBranch
+		err = json.NewDecoder(resp.Body).Decode(&pageBranches)
+		resp.Body.Close()
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to decode branches response for %s/%s: %w", owner, repo, err)
+		}
+
+		branches = append(branches, pageBranches...)
+		nextURL = parseNextLink(resp.Header.Get("Link"))
 	}
 
-	return branches, nil
+	return branches, false, nil
 }
 
-func (g *GitHubProvider) getCommitsFromBranch(owner, repo string, branch GitHubBranch, since time.Time) ([]Commit, error) {
+func (g *GitHubProvider) getCommitsFromBranch(ctx context.Context, apiBase, owner, repo string, branch GitHubBranch, path string, since, until time.Time) ([]Commit, bool, error) {
 	sinceStr := since.UTC().Format(time.RFC3339)
 
-	apiURL := fmt.Sprintf("https://api.github.com/repos/%s/%s/commits", owner, repo)
+	apiURL := fmt.Sprintf("%s/repos/%s/%s/commits", apiBase, owner, repo)
 	params := url.Values{}
 	params.Add("sha", branch.Name)
+	if path != "" {
+		params.Add("path", path)
+	}
 	params.Add("since", sinceStr)
+	if !until.IsZero() {
+		params.Add("until", until.UTC().Format(time.RFC3339))
+	}
 	params.Add("per_page", "100")
 
-	fullURL := apiURL + "?" + params.Encode()
+	nextURL := apiURL + "?" + params.Encode()
 
-	resp, err := g.makeRequest(fullURL)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get commits for %s/%s branch %s: %w", owner, repo, branch.Name, err)
-	}
-	defer resp.Body.Close()
+	var commits []Commit
+	for page := 0; nextURL != ""; page++ {
+		if page >= g.maxPages {
+			return commits, true, nil
+		}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("GitHub API error for %s/%s branch %s commits: HTTP %d", owner, repo, branch.Name, resp.StatusCode)
-	}
+		resp, err := g.makeRequest(ctx, nextURL)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to get commits for %s/%s branch %s: %w", owner, repo, branch.Name, err)
+		}
 
-	var githubCommits []GitHubCommit
-	if err := json.NewDecoder(resp.Body).Decode(&githubCommits); err != nil {
-		return nil, fmt.Errorf("failed to decode commits response for %s/%s branch %s: %w", owner, repo, branch.Name, err)
-	}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, false, fmt.Errorf("GitHub API error for %s/%s branch %s commits: HTTP %d", owner, repo, branch.Name, resp.StatusCode)
+		}
 
-	var commits []Commit
-	for _, gc := range githubCommits {
-		commits = append(commits, Commit{
-			Hash:      gc.SHA,
-			Branch:    branch.Name,
-			Author:    gc.Commit.Author.Name,
-			Message:   gc.Commit.Message,
-			Timestamp: gc.Commit.Author.Date,
-		})
+		var githubCommits []GitHubCommit
+		err = json.NewDecoder(resp.Body).Decode(&githubCommits)
+		resp.Body.Close()
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to decode commits response for %s/%s branch %s: %w", owner, repo, branch.Name, err)
+		}
+
+		for _, gc := range githubCommits {
+			var parents []string
+			for _, parent := range gc.Parents {
+				parents = append(parents, parent.SHA)
+			}
+
+			commits = append(commits, Commit{
+				Hash:        gc.SHA,
+				Branch:      branch.Name,
+				Author:      gc.Commit.Author.Name,
+				AuthorEmail: gc.Commit.Author.Email,
+				Message:     gc.Commit.Message,
+				Timestamp:   gc.Commit.Author.Date,
+
+				Committer:      gc.Commit.Committer.Name,
+				CommitterEmail: gc.Commit.Committer.Email,
+				CommitTime:     gc.Commit.Committer.Date,
+
+				ParentCount: len(gc.Parents),
+				Parents:     parents,
+			})
+		}
+
+		nextURL = parseNextLink(resp.Header.Get("Link"))
 	}
 
-	return commits, nil
+	return commits, false, nil
+}
+
+func parseNextLink(linkHeader string) string {
+	for _, link := range strings.Split(linkHeader, ",") {
+		segments := strings.Split(link, ";")
+		if len(segments) < 2 {
+			continue
+		}
+
+		target := strings.Trim(strings.TrimSpace(segments[0]), "<>")
+		for _, param := range segments[1:] {
+			if strings.TrimSpace(param) == `rel="next"` {
+				return target
+			}
+		}
+	}
+	return ""
 }
@@ -0,0 +1,56 @@
+package git
+
+import "testing"
+
+func TestParseNextLink(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   string
+	}{
+		{name: "empty header", header: "", want: ""},
+		{
+			name:   "next only",
+			header: `<https://api.github.com/repos/o/r/commits?page=2>; rel="next"`,
+			want:   "https://api.github.com/repos/o/r/commits?page=2",
+		},
+		{
+			name:   "next and last",
+			header: `<https://api.github.com/repos/o/r/commits?page=2>; rel="next", <https://api.github.com/repos/o/r/commits?page=5>; rel="last"`,
+			want:   "https://api.github.com/repos/o/r/commits?page=2",
+		},
+		{
+			name:   "next after prev and first",
+			header: `<https://api.github.com/r?page=1>; rel="first", <https://api.github.com/r?page=2>; rel="prev", <https://api.github.com/r?page=4>; rel="next"`,
+			want:   "https://api.github.com/r?page=4",
+		},
+		{
+			name:   "last page has no next",
+			header: `<https://api.github.com/r?page=1>; rel="first", <https://api.github.com/r?page=4>; rel="prev"`,
+			want:   "",
+		},
+		{
+			name:   "extra parameters and spacing",
+			header: `  <https://ghe.example.com/api/v3/r?page=3&per_page=100> ; type="text" ; rel="next" `,
+			want:   "https://ghe.example.com/api/v3/r?page=3&per_page=100",
+		},
+		{
+			name:   "segment without parameters",
+			header: `<https://api.github.com/r?page=9>, <https://api.github.com/r?page=2>; rel="next"`,
+			want:   "https://api.github.com/r?page=2",
+		},
+		{
+			name:   "other relation containing next",
+			header: `<https://api.github.com/r?page=2>; rel="nextish"`,
+			want:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseNextLink(tt.header); got != tt.want {
+				t.Errorf("parseNextLink(%q) = %q, want %q", tt.header, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,252 @@
+package git
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type GitLabProvider struct {
+	client      *http.Client
+	token       string
+	baseURL     string
+	concurrency int
+}
+
+type GitLabCommit struct {
+	ID           string    `json:"id"`
+	AuthorName   string    `json:"author_name"`
+	AuthorEmail  string    `json:"author_email"`
+	AuthoredDate time.Time `json:"authored_date"`
+	Message      string    `json:"message"`
+	ParentIDs    []string  `json:"parent_ids"`
+
+	CommitterName  string    `json:"committer_name"`
+	CommitterEmail string    `json:"committer_email"`
+	CommittedDate  time.Time `json:"committed_date"`
+}
+
+type GitLabBranch struct {
+	Name   string `json:"name"`
+	Commit struct {
+		ID string `json:"id"`
+	} `json:"commit"`
+}
+
+const gitLabPerPage = 100
+
+func NewGitLabProvider() *GitLabProvider {
+	return &GitLabProvider{
+		client:      &http.Client{Timeout: 30 * time.Second},
+		concurrency: DefaultConcurrency,
+	}
+}
+
+func (g *GitLabProvider) SetConcurrency(concurrency int) {
+	if concurrency > 0 {
+		g.concurrency = concurrency
+	}
+}
+
+func (g *GitLabProvider) SetToken(token string) {
+	g.token = token
+}
+
+func (g *GitLabProvider) SetBaseURL(baseURL string) {
+	g.baseURL = strings.TrimSuffix(baseURL, "/")
+}
+
+func (g *GitLabProvider) parseGitLabURL(repoURL string) (apiBase, projectPath string, err error) {
+	host := getRepoHost(repoURL)
+	projectPath = getRepoPath(repoURL)
+
+	if host == "" || !strings.Contains(projectPath, "/") {
+		return "", "", fmt.Errorf("invalid GitLab URL format: %s", repoURL)
+	}
+
+	if g.baseURL != "" {
+		return g.baseURL, projectPath, nil
+	}
+
+	scheme := "https"
+	if strings.HasPrefix(repoURL, "http://") {
+		scheme = "http"
+	}
+
+	return fmt.Sprintf("%s://%s/api/v4", scheme, host), projectPath, nil
+}
+
+func (g *GitLabProvider) makeRequest(ctx context.Context, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	if g.token != "" {
+		req.Header.Set("PRIVATE-TOKEN", g.token)
+	}
+
+	resp, err := doLimited(g.client, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make HTTP request: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (g *GitLabProvider) getPaged(ctx context.Context, baseURL string, params url.Values, out func(resp *http.Response) error) error {
+	page := 1
+	for {
+		params.Set("per_page", strconv.Itoa(gitLabPerPage))
+		params.Set("page", strconv.Itoa(page))
+
+		resp, err := g.makeRequest(ctx, baseURL+"?"+params.Encode())
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return fmt.Errorf("GitLab API error: HTTP %d", resp.StatusCode)
+		}
+
+		err = out(resp)
+		nextPage := resp.Header.Get("X-Next-Page")
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		if nextPage == "" {
+			return nil
+		}
+
+		next, err := strconv.Atoi(nextPage)
+		if err != nil || next <= page {
+			return nil
+		}
+		page = next
+	}
+}
+
+func (g *GitLabProvider) getBranches(ctx context.Context, apiBase, projectPath string) ([]GitLabBranch, error) {
+	endpoint := fmt.Sprintf("%s/projects/%s/repository/branches", apiBase, url.PathEscape(projectPath))
+
+	var branches []GitLabBranch
+	err := g.getPaged(ctx, endpoint, url.Values{}, func(resp *http.Response) error {
+		var page []GitLabBranch
+		if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+			return fmt.Errorf("failed to decode branches response for %s: %w", projectPath, err)
+		}
+		branches = append(branches, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get branches for %s: %w", projectPath, err)
+	}
+
+	return branches, nil
+}
+
+func (g *GitLabProvider) getCommitsFromBranch(ctx context.Context, apiBase, projectPath string, branch GitLabBranch, path string, since, until time.Time) ([]Commit, error) {
+	endpoint := fmt.Sprintf("%s/projects/%s/repository/commits", apiBase, url.PathEscape(projectPath))
+	params := url.Values{}
+	params.Set("ref_name", branch.Name)
+	if path != "" {
+		params.Set("path", path)
+	}
+	params.Set("since", since.UTC().Format(time.RFC3339))
+	if !until.IsZero() {
+		params.Set("until", until.UTC().Format(time.RFC3339))
+	}
+
+	var commits []Commit
+	err := g.getPaged(ctx, endpoint, params, func(resp *http.Response) error {
+		var page []GitLabCommit
+		if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+			return fmt.Errorf("failed to decode commits response for %s branch %s: %w", projectPath, branch.Name, err)
+		}
+		for _, gc := range page {
+			commits = append(commits, Commit{
+				Hash:        gc.ID,
+				Branch:      branch.Name,
+				Author:      gc.AuthorName,
+				AuthorEmail: gc.AuthorEmail,
+				Message:     gc.Message,
+				Timestamp:   gc.AuthoredDate,
+
+				Committer:      gc.CommitterName,
+				CommitterEmail: gc.CommitterEmail,
+				CommitTime:     gc.CommittedDate,
+
+				ParentCount: len(gc.ParentIDs),
+				Parents:     gc.ParentIDs,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commits for %s branch %s: %w", projectPath, branch.Name, err)
+	}
+
+	return commits, nil
+}
+
+func (g *GitLabProvider) getCommits(ctx context.Context, repoURL string, since, until time.Time, branchNames, pathPatterns []string) ([]Commit, error) {
+	apiBase, projectPath, err := g.parseGitLabURL(repoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	branches, err := g.getBranches(ctx, apiBase, projectPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get branches: %w", err)
+	}
+
+	var matched []GitLabBranch
+	for _, branch := range branches {
+		if branchMatches(branch.Name, branchNames) {
+			matched = append(matched, branch)
+		}
+	}
+
+	paths := pathParams(pathPatterns)
+
+	branchCommits := make([][]Commit, len(matched)*len(paths))
+	branchErrors := make([]error, len(branchCommits))
+	err = runLimited(ctx, g.concurrency, len(branchCommits), func(ctx context.Context, i int) error {
+		branch := matched[i/len(paths)]
+		commits, err := g.getCommitsFromBranch(ctx, apiBase, projectPath, branch, paths[i%len(paths)], since, until)
+		if err != nil {
+			branchErrors[i] = &BranchError{Branch: branch.Name, Err: err}
+			return nil
+		}
+		branchCommits[i] = commits
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mergeBranchCommits(branchCommits), errors.Join(branchErrors...)
+}
+
+func (g *GitLabProvider) Query(ctx context.Context, repoURL string, query CommitQuery) ([]Commit, error) {
+	if hasPathGlobs(query.Paths) {
+		return nil, unsupportedPathGlobs("GitLab")
+	}
+
+	commits, err := g.getCommits(ctx, repoURL, query.Since, query.Until, query.Branches, query.Paths)
+	if _, partial := partialErrors(err); !partial {
+		return nil, err
+	}
+
+	return applyCommitQuery(commits, query), err
+}
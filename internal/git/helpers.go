@@ -1,31 +1,535 @@
 package git
 
 import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
 	"strings"
 )
 
 func isRemoteURL(input string) bool {
 	return strings.HasPrefix(input, "http://") ||
 		strings.HasPrefix(input, "https://") ||
-		strings.HasPrefix(input, "git@")
+		strings.HasPrefix(input, "git@") ||
+		strings.HasPrefix(input, "ssh://") ||
+		strings.HasPrefix(input, "git://") ||
+		strings.HasPrefix(input, "file://")
+}
+
+func isPlainGitURL(input string) bool {
+	return strings.HasPrefix(input, "ssh://") ||
+		strings.HasPrefix(input, "git://") ||
+		strings.HasPrefix(input, "file://")
 }
 
 func detectProvider(repoURL string) (GitProvider, error) {
-	if strings.Contains(repoURL, "github.com") {
-		provider := NewGitHubProvider()
+	gitConfig, err := LoadGitConfig()
+	if err != nil {
+		gitConfig = &GitConfig{}
+	}
 
-		if gitConfig, err := LoadGitConfig(); err == nil && gitConfig.GitHubToken != "" {
-			provider.SetToken(gitConfig.GitHubToken)
-		}
+	host := gitConfig.Hosts[getRepoHost(repoURL)]
+	concurrency := gitConfig.concurrencyLimit()
 
+	switch providerTypeFor(repoURL, gitConfig) {
+	case "gitlab":
+		provider := NewGitLabProvider()
+		provider.SetConcurrency(concurrency)
+		if host.APIURL != "" {
+			provider.SetBaseURL(host.APIURL)
+		}
+		if token := tokenFor(repoURL, gitConfig.GitLabToken, gitConfig); token != "" {
+			provider.SetToken(token)
+		}
+		return provider, nil
+	case "gitea":
+		provider := NewGiteaProvider()
+		provider.SetConcurrency(concurrency)
+		if host.APIURL != "" {
+			provider.SetBaseURL(host.APIURL)
+		}
+		if token := tokenFor(repoURL, "", gitConfig); token != "" {
+			provider.SetToken(token)
+		}
+		return provider, nil
+	case "bitbucket":
+		provider := NewBitbucketCloudProvider()
+		provider.SetConcurrency(concurrency)
+		provider.SetCredentials(gitConfig.BitbucketUsername, gitConfig.BitbucketAppPassword)
+		if token, ok := gitConfig.RepoTokens[repoURL]; ok && token != "" {
+			provider.SetToken(token)
+		}
+		return provider, nil
+	case "mirror":
+		provider := NewMirrorProvider()
+		fallbackToken := ""
+		switch getRepoHost(repoURL) {
+		case "github.com":
+			fallbackToken = gitConfig.GitHubToken
+		case "gitlab.com":
+			fallbackToken = gitConfig.GitLabToken
+		}
+		if token := tokenFor(repoURL, fallbackToken, gitConfig); token != "" {
+			provider.SetToken(token)
+		}
+		return provider, nil
+	case "bitbucket-server":
+		provider := NewBitbucketServerProvider()
+		provider.SetConcurrency(concurrency)
+		if host.APIURL != "" {
+			provider.SetBaseURL(host.APIURL)
+		}
+		if token := tokenFor(repoURL, gitConfig.BitbucketServerToken, gitConfig); token != "" {
+			provider.SetToken(token)
+		}
 		return provider, nil
 	}
 
 	provider := NewGitHubProvider()
-
-	if gitConfig, err := LoadGitConfig(); err == nil && gitConfig.GitHubToken != "" {
-		provider.SetToken(gitConfig.GitHubToken)
+	provider.SetMaxPages(gitConfig.MaxPages)
+	provider.SetConcurrency(concurrency)
+	if host.APIURL != "" {
+		provider.SetBaseURL(host.APIURL)
+	}
+	if token := tokenFor(repoURL, gitConfig.GitHubToken, gitConfig); token != "" {
+		provider.SetToken(token)
 	}
 
 	return provider, nil
 }
+
+func providerTypeFor(repoURL string, gitConfig *GitConfig) string {
+	if provider, ok := gitConfig.RepoProviders[repoURL]; ok && provider != "" {
+		return provider
+	}
+
+	if isPlainGitURL(repoURL) {
+		return "mirror"
+	}
+
+	host := getRepoHost(repoURL)
+	if registered, ok := gitConfig.Hosts[host]; ok && registered.Provider != "" {
+		return registered.Provider
+	}
+
+	switch {
+	case host == "github.com":
+		return "github"
+	case host == "gitea.com" || host == "codeberg.org":
+		return "gitea"
+	case host == "gitlab.com" || strings.HasPrefix(host, "gitlab."):
+		return "gitlab"
+	case host == "bitbucket.org":
+		return "bitbucket"
+	case isBitbucketServerPath(getRepoPath(repoURL)):
+		return "bitbucket-server"
+	}
+
+	return "github"
+}
+
+func isBitbucketServerPath(repoPath string) bool {
+	parts := strings.Split(repoPath, "/")
+	for i, part := range parts {
+		if part == "scm" && i+2 < len(parts) {
+			return true
+		}
+		if (part == "projects" || part == "users") && i+3 < len(parts) && parts[i+2] == "repos" {
+			return true
+		}
+	}
+	return false
+}
+
+func tokenFor(repoURL, providerToken string, gitConfig *GitConfig) string {
+	if token, ok := gitConfig.RepoTokens[repoURL]; ok && token != "" {
+		return token
+	}
+	if host, ok := gitConfig.Hosts[getRepoHost(repoURL)]; ok && host.Token != "" {
+		return host.Token
+	}
+	return providerToken
+}
+
+func getRepoHost(repoURL string) string {
+	rest := repoURL
+	if strings.HasPrefix(rest, "git@") {
+		rest = strings.TrimPrefix(rest, "git@")
+		if idx := strings.Index(rest, ":"); idx >= 0 {
+			rest = rest[:idx]
+		}
+		return strings.ToLower(rest)
+	}
+
+	if idx := strings.Index(rest, "://"); idx >= 0 {
+		rest = rest[idx+3:]
+	}
+	if idx := strings.Index(rest, "/"); idx >= 0 {
+		rest = rest[:idx]
+	}
+	if idx := strings.LastIndex(rest, "@"); idx >= 0 {
+		rest = rest[idx+1:]
+	}
+
+	return strings.ToLower(rest)
+}
+
+func getRepoPath(repoURL string) string {
+	rest := repoURL
+	if strings.HasPrefix(rest, "git@") {
+		if idx := strings.Index(rest, ":"); idx >= 0 {
+			rest = rest[idx+1:]
+		}
+	} else {
+		if idx := strings.Index(rest, "://"); idx >= 0 {
+			rest = rest[idx+3:]
+		}
+		if idx := strings.Index(rest, "/"); idx >= 0 {
+			rest = rest[idx+1:]
+		} else {
+			rest = ""
+		}
+	}
+
+	rest = strings.Trim(rest, "/")
+	rest = strings.TrimSuffix(rest, ".git")
+	return rest
+}
+
+func maskToken(token string) string {
+	if len(token) > 8 {
+		return token[:4] + "..." + token[len(token)-4:]
+	}
+	return token
+}
+
+func filterCommitsByAuthors(commits []Commit, authorNames []string, matchCoAuthors bool, aliases map[string]string) []Commit {
+	var targets []string
+	for _, name := range authorNames {
+		lookup := strings.TrimPrefix(name, "=")
+		if canonical := resolveAlias(lookup, lookup, aliases); canonical != lookup {
+			name = "=" + canonical
+		}
+		targets = append(targets, name)
+	}
+
+	var filtered []Commit
+	for _, commit := range commits {
+		if commitMatchesAuthors(commit, targets, matchCoAuthors) {
+			filtered = append(filtered, commit)
+		}
+	}
+
+	return filtered
+}
+
+func commitMatchesAuthors(commit Commit, targets []string, matchCoAuthors bool) bool {
+	for _, target := range targets {
+		if authorMatches(commit.Author, commit.AuthorEmail, target) {
+			return true
+		}
+		if !matchCoAuthors {
+			continue
+		}
+		for _, coAuthor := range commit.CoAuthors {
+			if authorMatches(coAuthor.Name, coAuthor.Email, target) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func applyCommitQuery(commits []Commit, query CommitQuery) []Commit {
+	annotateCoAuthors(commits)
+	annotateConventionalCommits(commits)
+	applyAliases(commits, query.Aliases)
+
+	if len(query.Authors) > 0 {
+		commits = filterCommitsByAuthors(commits, query.Authors, query.MatchCoAuthors, query.Aliases)
+	}
+
+	if query.Merges != "" && query.Merges != "on" {
+		commits = filterCommitsByMerges(commits, query.Merges)
+	}
+
+	if len(query.Types) > 0 {
+		commits = filterCommitsByTypes(commits, query.Types)
+	}
+
+	if query.Message != "" {
+		commits = filterCommitsByMessage(commits, query.Message, query.MessageCaseSensitive)
+	}
+
+	if query.Until.IsZero() {
+		return commits
+	}
+
+	var filtered []Commit
+	for _, commit := range commits {
+		if !commit.Timestamp.After(query.Until) {
+			filtered = append(filtered, commit)
+		}
+	}
+
+	return filtered
+}
+
+func filterCommitsByMerges(commits []Commit, mode string) []Commit {
+	var filtered []Commit
+	for _, commit := range commits {
+		isMerge := commit.ParentCount > 1
+		if (mode == "only" && isMerge) || (mode == "off" && !isMerge) {
+			filtered = append(filtered, commit)
+		}
+	}
+
+	return filtered
+}
+
+func filterCommitsByMessage(commits []Commit, pattern string, caseSensitive bool) []Commit {
+	var matches func(string) bool
+	if expr, ok := strings.CutPrefix(pattern, "re:"); ok {
+		if !caseSensitive {
+			expr = "(?i)" + expr
+		}
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil
+		}
+		matches = re.MatchString
+	} else if caseSensitive {
+		matches = func(message string) bool {
+			return strings.Contains(message, pattern)
+		}
+	} else {
+		patternLower := strings.ToLower(pattern)
+		matches = func(message string) bool {
+			return strings.Contains(strings.ToLower(message), patternLower)
+		}
+	}
+
+	var filtered []Commit
+	for _, commit := range commits {
+		if matches(commit.Message) {
+			filtered = append(filtered, commit)
+		}
+	}
+
+	return filtered
+}
+
+var defaultBranchNames = map[string]bool{
+	"main":        true,
+	"master":      true,
+	"trunk":       true,
+	"develop":     true,
+	"development": true,
+}
+
+func isDefaultBranch(branchName string) bool {
+	if defaultBranchNames[branchName] {
+		return true
+	}
+
+	remote, name, ok := strings.Cut(branchName, "/")
+	return ok && (remote == "origin" || remote == "upstream") && defaultBranchNames[name]
+}
+
+func primaryBranch(hash string, branches []string, mainline map[string]string) string {
+	if len(branches) == 0 {
+		return ""
+	}
+
+	if branch, ok := mainline[hash]; ok && slices.Contains(branches, branch) {
+		return branch
+	}
+
+	var features, defaults []string
+	for _, branch := range branches {
+		if isDefaultBranch(branch) {
+			defaults = append(defaults, branch)
+		} else {
+			features = append(features, branch)
+		}
+	}
+
+	if len(features) > 0 {
+		return features[0]
+	}
+	return defaults[0]
+}
+
+func branchMatches(branchName string, branchNames []string) bool {
+	return anyBranchNameMatches([]string{branchName}, branchNames)
+}
+
+func remoteBranchMatches(remoteBranch string, branchNames []string) bool {
+	names := []string{remoteBranch}
+	if _, branch, ok := strings.Cut(remoteBranch, "/"); ok && branch != "" {
+		names = append(names, branch)
+	}
+	return anyBranchNameMatches(names, branchNames)
+}
+
+func anyBranchNameMatches(names, branchNames []string) bool {
+	if len(branchNames) == 0 {
+		return true
+	}
+
+	patternMatches := func(pattern string) bool {
+		for _, name := range names {
+			if branchPatternMatches(name, pattern) {
+				return true
+			}
+		}
+		return false
+	}
+
+	included, hasIncludes := false, false
+	for _, pattern := range branchNames {
+		if negated, ok := strings.CutPrefix(pattern, "!"); ok {
+			if patternMatches(negated) {
+				return false
+			}
+			continue
+		}
+
+		hasIncludes = true
+		if !included && patternMatches(pattern) {
+			included = true
+		}
+	}
+
+	return included || !hasIncludes
+}
+
+func branchPatternMatches(branchName, pattern string) bool {
+	if expr, ok := strings.CutPrefix(pattern, "re:"); ok {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return false
+		}
+		return re.MatchString(branchName)
+	}
+
+	if strings.ContainsAny(pattern, "*?[") {
+		re, err := regexp.Compile("(?i)^" + globToRegexp(pattern) + "$")
+		if err != nil {
+			return false
+		}
+		return re.MatchString(branchName)
+	}
+
+	return strings.Contains(strings.ToLower(branchName), strings.ToLower(pattern))
+}
+
+func newPathFilter(patterns []string) func(string) bool {
+	var prefixes []string
+	var globs []*regexp.Regexp
+	for _, pattern := range patterns {
+		pattern = strings.Trim(strings.TrimSpace(pattern), "/")
+		if pattern == "" {
+			continue
+		}
+		if strings.ContainsAny(pattern, "*?[") {
+			if re, err := regexp.Compile("^" + globToRegexp(pattern) + "(/.*)?$"); err == nil {
+				globs = append(globs, re)
+			}
+			continue
+		}
+		prefixes = append(prefixes, pattern)
+	}
+
+	return func(filePath string) bool {
+		for _, prefix := range prefixes {
+			if filePath == prefix || strings.HasPrefix(filePath, prefix+"/") {
+				return true
+			}
+		}
+		for _, re := range globs {
+			if re.MatchString(filePath) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func pathParams(patterns []string) []string {
+	seen := make(map[string]bool)
+	var paths []string
+	for _, pattern := range patterns {
+		prefix := pathPatternPrefix(pattern)
+		if prefix == "" {
+			return []string{""}
+		}
+		if !seen[prefix] {
+			seen[prefix] = true
+			paths = append(paths, prefix)
+		}
+	}
+
+	if len(paths) == 0 {
+		return []string{""}
+	}
+	return paths
+}
+
+func hasPathGlobs(patterns []string) bool {
+	for _, pattern := range patterns {
+		if strings.ContainsAny(pattern, "*?[") {
+			return true
+		}
+	}
+	return false
+}
+
+func unsupportedPathGlobs(provider string) error {
+	return fmt.Errorf("%s does not support glob path filters, use plain file or directory paths", provider)
+}
+
+func pathPatternPrefix(pattern string) string {
+	pattern = strings.Trim(strings.TrimSpace(pattern), "/")
+	idx := strings.IndexAny(pattern, "*?[")
+	if idx < 0 {
+		return pattern
+	}
+
+	prefix := pattern[:idx]
+	if slash := strings.LastIndex(prefix, "/"); slash >= 0 {
+		return prefix[:slash]
+	}
+	return ""
+}
+
+func globToRegexp(glob string) string {
+	var expr strings.Builder
+	inClass := false
+	for _, r := range glob {
+		switch {
+		case inClass:
+			if r == ']' {
+				inClass = false
+			}
+			expr.WriteRune(r)
+		case r == '*':
+			expr.WriteString(".*")
+		case r == '?':
+			expr.WriteString(".")
+		case r == '[':
+			inClass = true
+			expr.WriteRune(r)
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	return expr.String()
+}
+
+func sortCommitsByTimestamp(commits []Commit) {
+	sort.SliceStable(commits, func(i, j int) bool {
+		return commits[i].Timestamp.After(commits[j].Timestamp)
+	})
+}
@@ -0,0 +1,77 @@
+package git
+
+import "testing"
+
+func TestGlobToRegexp(t *testing.T) {
+	tests := []struct {
+		glob string
+		want string
+	}{
+		{glob: "main", want: "main"},
+		{glob: "feature/*", want: "feature/.*"},
+		{glob: "v?.x", want: `v.\.x`},
+		{glob: "release-[0-9]", want: "release-[0-9]"},
+		{glob: "[*?]", want: "[*?]"},
+		{glob: "a+b(c)", want: `a\+b\(c\)`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.glob, func(t *testing.T) {
+			if got := globToRegexp(tt.glob); got != tt.want {
+				t.Errorf("globToRegexp(%q) = %q, want %q", tt.glob, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBranchMatches(t *testing.T) {
+	tests := []struct {
+		name     string
+		branch   string
+		remote   bool
+		patterns []string
+		want     bool
+	}{
+		{name: "no patterns", branch: "main", patterns: nil, want: true},
+		{name: "substring", branch: "release/1.0", patterns: []string{"release"}, want: true},
+		{name: "substring ignores case", branch: "Release/1.0", patterns: []string{"release"}, want: true},
+		{name: "substring miss", branch: "main", patterns: []string{"release"}, want: false},
+		{name: "glob star", branch: "feature/login", patterns: []string{"feature/*"}, want: true},
+		{name: "glob is anchored", branch: "old/feature/login", patterns: []string{"feature/*"}, want: false},
+		{name: "glob needs suffix", branch: "feature", patterns: []string{"feature/*"}, want: false},
+		{name: "glob ignores case", branch: "Feature/Login", patterns: []string{"feature/*"}, want: true},
+		{name: "glob question mark", branch: "v1", patterns: []string{"v?"}, want: true},
+		{name: "glob class", branch: "release-3", patterns: []string{"release-[0-9]"}, want: true},
+		{name: "glob class miss", branch: "release-x", patterns: []string{"release-[0-9]"}, want: false},
+		{name: "regex", branch: "hotfix-12", patterns: []string{`re:^hotfix-\d+`}, want: true},
+		{name: "regex is case sensitive", branch: "Hotfix-12", patterns: []string{`re:^hotfix-\d+`}, want: false},
+		{name: "regex with comma", branch: "aaa", patterns: []string{"re:^a{1,3}$"}, want: true},
+		{name: "invalid regex", branch: "main", patterns: []string{"re:("}, want: false},
+		{name: "negated glob", branch: "dependabot/npm", patterns: []string{"!dependabot/*"}, want: false},
+		{name: "only negations keep others", branch: "main", patterns: []string{"!dependabot/*"}, want: true},
+		{name: "include and exclude", branch: "feature/wip", patterns: []string{"feature/*", "!*/wip"}, want: false},
+		{name: "include survives exclude", branch: "feature/login", patterns: []string{"feature/*", "!*/wip"}, want: true},
+		{name: "any include", branch: "main", patterns: []string{"feature/*", "main"}, want: true},
+		{name: "negated regex", branch: "hotfix-1", patterns: []string{`!re:^hotfix-\d+$`}, want: false},
+		{name: "remote glob", branch: "origin/feature/login", remote: true, patterns: []string{"feature/*"}, want: true},
+		{name: "remote negated glob", branch: "origin/dependabot/npm/x", remote: true, patterns: []string{"!dependabot/*"}, want: false},
+		{name: "remote glob include", branch: "origin/dependabot/npm/x", remote: true, patterns: []string{"dependabot/*"}, want: true},
+		{name: "remote exact", branch: "upstream/main", remote: true, patterns: []string{"re:^main$"}, want: true},
+		{name: "remote full name", branch: "origin/main", remote: true, patterns: []string{"origin/*"}, want: true},
+		{name: "remote negated full name", branch: "upstream/main", remote: true, patterns: []string{"!upstream/*"}, want: false},
+		{name: "remote miss", branch: "origin/main", remote: true, patterns: []string{"feature/*"}, want: false},
+		{name: "local name is not stripped", branch: "dependabot/npm/x", patterns: []string{"npm/*"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match, matchName := branchMatches, "branchMatches"
+			if tt.remote {
+				match, matchName = remoteBranchMatches, "remoteBranchMatches"
+			}
+			if got := match(tt.branch, tt.patterns); got != tt.want {
+				t.Errorf("%s(%q, %q) = %v, want %v", matchName, tt.branch, tt.patterns, got, tt.want)
+			}
+		})
+	}
+}
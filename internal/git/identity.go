@@ -0,0 +1,220 @@
+package git
+
+import (
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/go-git/go-git/v5"
+)
+
+type mailmapEntry struct {
+	properName  string
+	properEmail string
+	commitName  string
+	commitEmail string
+}
+
+type mailmap struct {
+	entries []mailmapEntry
+}
+
+func parseMailmap(data string) *mailmap {
+	m := &mailmap{}
+
+	for _, line := range strings.Split(data, "\n") {
+		if idx := strings.Index(line, "#"); idx >= 0 {
+			line = line[:idx]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		var names, emails []string
+		rest := line
+		for {
+			start := strings.Index(rest, "<")
+			if start < 0 {
+				break
+			}
+			end := strings.Index(rest[start:], ">")
+			if end < 0 {
+				break
+			}
+			names = append(names, strings.TrimSpace(rest[:start]))
+			emails = append(emails, strings.TrimSpace(rest[start+1:start+end]))
+			rest = rest[start+end+1:]
+		}
+
+		switch len(emails) {
+		case 1:
+			if names[0] != "" {
+				m.entries = append(m.entries, mailmapEntry{properName: names[0], commitEmail: emails[0]})
+			}
+		case 2:
+			m.entries = append(m.entries, mailmapEntry{
+				properName:  names[0],
+				properEmail: emails[0],
+				commitName:  names[1],
+				commitEmail: emails[1],
+			})
+		}
+	}
+
+	return m
+}
+
+func (m *mailmap) resolve(name, email string) (string, string) {
+	if m == nil {
+		return name, email
+	}
+
+	var match *mailmapEntry
+	for i := range m.entries {
+		entry := &m.entries[i]
+		if !strings.EqualFold(entry.commitEmail, email) {
+			continue
+		}
+		if entry.commitName != "" {
+			if strings.EqualFold(entry.commitName, name) {
+				match = entry
+				break
+			}
+			continue
+		}
+		if match == nil {
+			match = entry
+		}
+	}
+
+	if match == nil {
+		return name, email
+	}
+	if match.properName != "" {
+		name = match.properName
+	}
+	if match.properEmail != "" {
+		email = match.properEmail
+	}
+	return name, email
+}
+
+func loadRepoMailmap(repo *git.Repository) *mailmap {
+	if worktree, err := repo.Worktree(); err == nil {
+		if file, err := worktree.Filesystem.Open(".mailmap"); err == nil {
+			defer file.Close()
+			if data, err := io.ReadAll(file); err == nil {
+				return parseMailmap(string(data))
+			}
+		}
+		return nil
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		return nil
+	}
+	commit, err := repo.CommitObject(head.Hash())
+	if err != nil {
+		return nil
+	}
+	file, err := commit.File(".mailmap")
+	if err != nil {
+		return nil
+	}
+	data, err := file.Contents()
+	if err != nil {
+		return nil
+	}
+	return parseMailmap(data)
+}
+
+func applyMailmap(commits []Commit, m *mailmap) {
+	if m == nil {
+		return
+	}
+
+	for i := range commits {
+		commits[i].Author, commits[i].AuthorEmail = m.resolve(commits[i].Author, commits[i].AuthorEmail)
+		if commits[i].Committer != "" {
+			commits[i].Committer, commits[i].CommitterEmail = m.resolve(commits[i].Committer, commits[i].CommitterEmail)
+		}
+		for j := range commits[i].CoAuthors {
+			coAuthor := &commits[i].CoAuthors[j]
+			coAuthor.Name, coAuthor.Email = m.resolve(coAuthor.Name, coAuthor.Email)
+		}
+	}
+}
+
+func resolveAlias(name, email string, aliases map[string]string) string {
+	if canonical, ok := aliases[strings.ToLower(email)]; ok && email != "" {
+		return canonical
+	}
+	if canonical, ok := aliases[strings.ToLower(name)]; ok {
+		return canonical
+	}
+	return name
+}
+
+func applyAliases(commits []Commit, aliases map[string]string) {
+	if len(aliases) == 0 {
+		return
+	}
+
+	for i := range commits {
+		commits[i].Author = resolveAlias(commits[i].Author, commits[i].AuthorEmail, aliases)
+		if commits[i].Committer != "" {
+			commits[i].Committer = resolveAlias(commits[i].Committer, commits[i].CommitterEmail, aliases)
+		}
+		for j := range commits[i].CoAuthors {
+			coAuthor := &commits[i].CoAuthors[j]
+			coAuthor.Name = resolveAlias(coAuthor.Name, coAuthor.Email, aliases)
+		}
+	}
+}
+
+func nameTokens(name string) []string {
+	return strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func authorMatches(name, email, target string) bool {
+	if exact, ok := strings.CutPrefix(target, "="); ok {
+		exact = strings.TrimSpace(exact)
+		return strings.EqualFold(name, exact) || (email != "" && strings.EqualFold(email, exact))
+	}
+
+	if strings.EqualFold(name, target) || (email != "" && strings.EqualFold(email, target)) {
+		return true
+	}
+
+	if strings.HasPrefix(target, "@") {
+		return email != "" && strings.HasSuffix(strings.ToLower(email), strings.ToLower(target))
+	}
+	if strings.Contains(target, "@") {
+		return false
+	}
+
+	targetTokens := nameTokens(target)
+	if len(targetTokens) == 0 {
+		return false
+	}
+
+	nameParts := nameTokens(name)
+	for start := 0; start+len(targetTokens) <= len(nameParts); start++ {
+		matched := true
+		for i, token := range targetTokens {
+			if nameParts[start+i] != token {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+
+	return false
+}
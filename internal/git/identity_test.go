@@ -0,0 +1,93 @@
+package git
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseMailmap(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want []mailmapEntry
+	}{
+		{
+			name: "name only",
+			data: "Jane Doe <jane@old.example>",
+			want: []mailmapEntry{{properName: "Jane Doe", commitEmail: "jane@old.example"}},
+		},
+		{
+			name: "email only",
+			data: "<jane@example.com> <jane@old.example>",
+			want: []mailmapEntry{{properEmail: "jane@example.com", commitEmail: "jane@old.example"}},
+		},
+		{
+			name: "name and email",
+			data: "Jane Doe <jane@example.com> <jane@old.example>",
+			want: []mailmapEntry{{properName: "Jane Doe", properEmail: "jane@example.com", commitEmail: "jane@old.example"}},
+		},
+		{
+			name: "with replacement name",
+			data: "Jane Doe <jane@example.com> jdoe <jane@old.example>",
+			want: []mailmapEntry{{properName: "Jane Doe", properEmail: "jane@example.com", commitName: "jdoe", commitEmail: "jane@old.example"}},
+		},
+		{
+			name: "comments and blank lines",
+			data: "# authors\n\n  Jane Doe <jane@old.example>  # old laptop\n",
+			want: []mailmapEntry{{properName: "Jane Doe", commitEmail: "jane@old.example"}},
+		},
+		{
+			name: "bare email is ignored",
+			data: "<jane@old.example>",
+			want: nil,
+		},
+		{
+			name: "unterminated email is ignored",
+			data: "Jane Doe <jane@old.example",
+			want: nil,
+		},
+		{
+			name: "no email is ignored",
+			data: "Jane Doe",
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseMailmap(tt.data).entries; !slices.Equal(got, tt.want) {
+				t.Errorf("parseMailmap(%q) = %+v, want %+v", tt.data, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMailmapResolve(t *testing.T) {
+	m := parseMailmap(`Jane Doe <jane@old.example>
+<john@example.com> <john@old.example>
+Ann Lee <ann@example.com> <ann@old.example>
+Bot Owner <owner@example.com> ci-bot <bot@example.com>
+`)
+
+	tests := []struct {
+		name, email         string
+		wantName, wantEmail string
+	}{
+		{name: "jd", email: "jane@old.example", wantName: "Jane Doe", wantEmail: "jane@old.example"},
+		{name: "jd", email: "JANE@old.example", wantName: "Jane Doe", wantEmail: "JANE@old.example"},
+		{name: "John", email: "john@old.example", wantName: "John", wantEmail: "john@example.com"},
+		{name: "ann", email: "ann@old.example", wantName: "Ann Lee", wantEmail: "ann@example.com"},
+		{name: "ci-bot", email: "bot@example.com", wantName: "Bot Owner", wantEmail: "owner@example.com"},
+		{name: "other-bot", email: "bot@example.com", wantName: "other-bot", wantEmail: "bot@example.com"},
+		{name: "Stranger", email: "stranger@example.com", wantName: "Stranger", wantEmail: "stranger@example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+" "+tt.email, func(t *testing.T) {
+			gotName, gotEmail := m.resolve(tt.name, tt.email)
+			if gotName != tt.wantName || gotEmail != tt.wantEmail {
+				t.Errorf("resolve(%q, %q) = %q, %q, want %q, %q", tt.name, tt.email, gotName, gotEmail, tt.wantName, tt.wantEmail)
+			}
+		})
+	}
+}
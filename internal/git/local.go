@@ -0,0 +1,356 @@
+package git
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"project-void/internal/helpers"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	commitgraphfmt "github.com/go-git/go-git/v5/plumbing/format/commitgraph/v2"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/object/commitgraph"
+	"github.com/go-git/go-git/v5/storage/filesystem"
+)
+
+type walkItem struct {
+	node   commitgraph.CommitNode
+	branch string
+	order  int
+}
+
+type walkQueue []*walkItem
+
+func (q walkQueue) Len() int { return len(q) }
+
+func (q walkQueue) Less(i, j int) bool {
+	ti, tj := q[i].node.CommitTime(), q[j].node.CommitTime()
+	if ti.Equal(tj) {
+		return q[i].order < q[j].order
+	}
+	return ti.After(tj)
+}
+
+func (q walkQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *walkQueue) Push(x any) { *q = append(*q, x.(*walkItem)) }
+
+func (q *walkQueue) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	old[len(old)-1] = nil
+	*q = old[:len(old)-1]
+	return item
+}
+
+func openCommitNodeIndex(repo *git.Repository) (commitgraph.CommitNodeIndex, func()) {
+	if storage, ok := repo.Storer.(*filesystem.Storage); ok {
+		if index, err := commitgraphfmt.OpenChainOrFileIndex(storage.Filesystem()); err == nil {
+			return commitgraph.NewGraphCommitNodeIndex(index, repo.Storer), func() { index.Close() }
+		}
+	}
+	return commitgraph.NewObjectCommitNodeIndex(repo.Storer), func() {}
+}
+
+func getCommitsLocal(ctx context.Context, repoPath string, query CommitQuery) ([]Commit, error) {
+	since := helpers.ToMidnight(query.Since)
+
+	repo, err := git.PlainOpen(repoPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open repository: %w", err)
+	}
+
+	index, closeIndex := openCommitNodeIndex(repo)
+	defer closeIndex()
+
+	refs, err := repo.References()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get references: %w", err)
+	}
+
+	visited := make(map[plumbing.Hash]bool)
+	queue := &walkQueue{}
+	order := 0
+
+	var refNames []string
+	refTips := make(map[string]plumbing.Hash)
+	refSets := make(map[plumbing.Hash][]uint64)
+	mergeRefSet := func(hash plumbing.Hash, bits []uint64) bool {
+		set := refSets[hash]
+		if len(set) < len(bits) {
+			set = append(set, make([]uint64, len(bits)-len(set))...)
+		}
+		changed := false
+		for i, word := range bits {
+			if set[i]|word != set[i] {
+				set[i] |= word
+				changed = true
+			}
+		}
+		refSets[hash] = set
+		return changed
+	}
+
+	// Commit times are not guaranteed to decrease along history, so a commit
+	// can be expanded before all of its children have been. Bits that reach
+	// an already expanded commit are carried on to its ancestors here.
+	expanded := make(map[plumbing.Hash][]plumbing.Hash)
+	propagateRefSet := func(hash plumbing.Hash, bits []uint64) {
+		stack := []plumbing.Hash{hash}
+		for len(stack) > 0 {
+			hash := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if mergeRefSet(hash, bits) {
+				stack = append(stack, expanded[hash]...)
+			}
+		}
+	}
+
+	push := func(node commitgraph.CommitNode, branch string) {
+		if visited[node.ID()] {
+			return
+		}
+		visited[node.ID()] = true
+		heap.Push(queue, &walkItem{node: node, branch: branch, order: order})
+		order++
+	}
+
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		if ref.Type() != plumbing.HashReference || !isBranchRef(ref.Name()) {
+			return nil
+		}
+
+		if ref.Name().IsRemote() {
+			if !remoteBranchMatches(ref.Name().Short(), query.Branches) {
+				return nil
+			}
+		} else if !branchMatches(ref.Name().Short(), query.Branches) {
+			return nil
+		}
+
+		node, err := index.Get(ref.Hash())
+		if err != nil {
+			return nil
+		}
+
+		bit := len(refNames)
+		refNames = append(refNames, ref.Name().Short())
+		refTips[ref.Name().Short()] = ref.Hash()
+		bits := make([]uint64, bit/64+1)
+		bits[bit/64] = 1 << (bit % 64)
+		mergeRefSet(ref.Hash(), bits)
+
+		push(node, ref.Name().Short())
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error iterating references: %w", err)
+	}
+
+	var pathFilter func(string) bool
+	if len(query.Paths) > 0 {
+		pathFilter = newPathFilter(query.Paths)
+	}
+
+	var commits []Commit
+
+	for queue.Len() > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		item := heap.Pop(queue).(*walkItem)
+		if !item.node.CommitTime().After(since) {
+			continue
+		}
+
+		c, err := item.node.Commit()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read commit %s: %w", item.node.ID(), err)
+		}
+
+		inRange := c.Author.When.After(since) && (query.Until.IsZero() || !c.Author.When.After(query.Until))
+		if inRange && pathFilter != nil && !commitTouchesPaths(c, query.Paths, pathFilter) {
+			inRange = false
+		}
+
+		if inRange {
+			var parents []string
+			for _, parentHash := range c.ParentHashes {
+				parents = append(parents, parentHash.String())
+			}
+
+			commit := Commit{
+				Hash:        c.Hash.String(),
+				Branch:      item.branch,
+				Author:      c.Author.Name,
+				AuthorEmail: c.Author.Email,
+				Message:     c.Message,
+				Timestamp:   c.Author.When,
+
+				Committer:      c.Committer.Name,
+				CommitterEmail: c.Committer.Email,
+				CommitTime:     c.Committer.When,
+
+				ParentCount: c.NumParents(),
+				Parents:     parents,
+			}
+			if query.Stats {
+				if fileStats, err := c.Stats(); err == nil {
+					for _, fileStat := range fileStats {
+						commit.Additions += fileStat.Addition
+						commit.Deletions += fileStat.Deletion
+					}
+					commit.FilesChanged = len(fileStats)
+					commit.HasStats = true
+				}
+			}
+			commits = append(commits, commit)
+		}
+
+		expanded[item.node.ID()] = item.node.ParentHashes()
+		for _, parentHash := range item.node.ParentHashes() {
+			propagateRefSet(parentHash, refSets[item.node.ID()])
+			if visited[parentHash] {
+				continue
+			}
+			parent, err := index.Get(parentHash)
+			if err != nil {
+				continue
+			}
+			push(parent, item.branch)
+		}
+	}
+
+	mainline := make(map[string]string)
+	sortedRefs := append([]string(nil), refNames...)
+	sort.Strings(sortedRefs)
+	for _, name := range sortedRefs {
+		if !isDefaultBranch(name) {
+			continue
+		}
+		node, err := index.Get(refTips[name])
+		for err == nil && node.CommitTime().After(since) {
+			if _, marked := mainline[node.ID().String()]; !marked {
+				mainline[node.ID().String()] = name
+			}
+			parents := node.ParentHashes()
+			if len(parents) == 0 {
+				break
+			}
+			node, err = index.Get(parents[0])
+		}
+	}
+
+	for i := range commits {
+		bits := refSets[plumbing.NewHash(commits[i].Hash)]
+		var branches []string
+		for bit, name := range refNames {
+			if bit/64 < len(bits) && bits[bit/64]&(1<<(bit%64)) != 0 {
+				branches = append(branches, name)
+			}
+		}
+		if len(branches) > 0 {
+			sort.Strings(branches)
+			commits[i].Branches = branches
+			commits[i].Branch = primaryBranch(commits[i].Hash, branches, mainline)
+		}
+	}
+
+	annotateCoAuthors(commits)
+	applyMailmap(commits, loadRepoMailmap(repo))
+	sortCommitsByTimestamp(commits)
+
+	return applyCommitQuery(commits, query), nil
+}
+
+// commitTouchesPaths reports whether c changes a file matched by the path
+// patterns compared to its first parent. Only the subtrees below each
+// pattern's literal prefix are looked at, so a commit that leaves those
+// subtrees alone is rejected by comparing a few tree entry hashes instead of
+// diffing the whole tree.
+func commitTouchesPaths(c *object.Commit, patterns []string, pathFilter func(string) bool) bool {
+	tree, err := c.Tree()
+	if err != nil {
+		return false
+	}
+
+	var parentTree *object.Tree
+	if c.NumParents() > 0 {
+		parent, err := c.Parent(0)
+		if err != nil {
+			return false
+		}
+		if parentTree, err = parent.Tree(); err != nil {
+			return false
+		}
+	}
+
+	for _, pattern := range patterns {
+		if strings.Trim(strings.TrimSpace(pattern), "/") == "" {
+			continue
+		}
+
+		prefix := pathPatternPrefix(pattern)
+		entry, found := treeEntryAt(tree, prefix)
+		parentEntry, parentFound := treeEntryAt(parentTree, prefix)
+		if found == parentFound && entry == parentEntry {
+			continue
+		}
+
+		if !hasPathGlobs([]string{pattern}) {
+			return true
+		}
+
+		changes, err := object.DiffTree(subtreeAt(parentTree, prefix), subtreeAt(tree, prefix))
+		if err != nil {
+			return false
+		}
+		for _, change := range changes {
+			if pathFilter(path.Join(prefix, change.From.Name)) || pathFilter(path.Join(prefix, change.To.Name)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func treeEntryAt(tree *object.Tree, entryPath string) (object.TreeEntry, bool) {
+	if tree == nil {
+		return object.TreeEntry{}, false
+	}
+	if entryPath == "" {
+		return object.TreeEntry{Mode: filemode.Dir, Hash: tree.Hash}, true
+	}
+
+	entry, err := tree.FindEntry(entryPath)
+	if err != nil {
+		return object.TreeEntry{}, false
+	}
+	return object.TreeEntry{Mode: entry.Mode, Hash: entry.Hash}, true
+}
+
+func subtreeAt(tree *object.Tree, treePath string) *object.Tree {
+	if tree == nil || treePath == "" {
+		return tree
+	}
+
+	subtree, err := tree.Tree(treePath)
+	if err != nil {
+		return nil
+	}
+	return subtree
+}
+
+func isBranchRef(name plumbing.ReferenceName) bool {
+	if name.IsBranch() {
+		return true
+	}
+	return name.IsRemote() && !strings.HasSuffix(name.String(), "/HEAD")
+}
@@ -0,0 +1,343 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+
+	"project-void/internal/helpers"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+)
+
+func TestGetCommitsLocalWalk(t *testing.T) {
+	dir := t.TempDir()
+	repo, err := git.PlainInit(dir, false)
+	if err != nil {
+		t.Fatalf("failed to init repository: %v", err)
+	}
+
+	treeHash, err := storeObject(repo.Storer, &object.Tree{})
+	if err != nil {
+		t.Fatalf("failed to store tree: %v", err)
+	}
+
+	now := time.Now()
+	daysAgo := func(days int) time.Time { return now.AddDate(0, 0, -days) }
+
+	commit := func(message string, authored, committed time.Time, parents ...plumbing.Hash) plumbing.Hash {
+		hash, err := storeCommit(repo.Storer, treeHash, parents, message, authored, committed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return hash
+	}
+
+	// main:    root <- a <- d <- rebased <- c
+	// side:                 d <- s
+	// feature:              d <- rebased
+	// s is older than d, so d is expanded before s is reached.
+	root := commit("root", daysAgo(30), daysAgo(30))
+	a := commit("a", daysAgo(6), daysAgo(6), root)
+	d := commit("d", daysAgo(5), daysAgo(5), a)
+	rebased := commit("rebased", daysAgo(20), daysAgo(2), d)
+	c := commit("c", daysAgo(1), daysAgo(1), rebased)
+	s := commit("s", daysAgo(8), daysAgo(8), d)
+
+	refs := map[plumbing.ReferenceName]plumbing.Hash{
+		plumbing.NewBranchReferenceName("main"):           c,
+		plumbing.NewRemoteReferenceName("origin", "main"): c,
+		plumbing.NewBranchReferenceName("side"):           s,
+		plumbing.NewBranchReferenceName("feature"):        rebased,
+		plumbing.NewBranchReferenceName("stale"):          root,
+	}
+	for name, hash := range refs {
+		if err := repo.Storer.SetReference(plumbing.NewHashReference(name, hash)); err != nil {
+			t.Fatalf("failed to set ref %s: %v", name, err)
+		}
+	}
+
+	commits, err := getCommitsLocal(context.Background(), dir, CommitQuery{Since: daysAgo(10)})
+	if err != nil {
+		t.Fatalf("getCommitsLocal failed: %v", err)
+	}
+
+	want := map[string][]string{
+		"c": {"main", "origin/main"},
+		"d": {"feature", "main", "origin/main", "side"},
+		"a": {"feature", "main", "origin/main", "side"},
+		"s": {"side"},
+	}
+
+	got := make(map[string][]string)
+	for _, commit := range commits {
+		if _, ok := got[commit.Message]; ok {
+			t.Errorf("commit %q returned more than once", commit.Message)
+		}
+		got[commit.Message] = commit.Branches
+	}
+
+	if len(got) != len(want) {
+		t.Errorf("got commits %v, want %v", got, want)
+	}
+	for message, wantBranches := range want {
+		gotBranches, ok := got[message]
+		if !ok {
+			t.Errorf("commit %q missing from results", message)
+			continue
+		}
+		if !slices.Equal(gotBranches, wantBranches) {
+			t.Errorf("commit %q branches = %q, want %q", message, gotBranches, wantBranches)
+		}
+	}
+}
+
+func TestCommitTouchesPaths(t *testing.T) {
+	repo, err := git.PlainInit(t.TempDir(), false)
+	if err != nil {
+		t.Fatalf("failed to init repository: %v", err)
+	}
+
+	blob := func(content string) plumbing.Hash {
+		encoded := repo.Storer.NewEncodedObject()
+		encoded.SetType(plumbing.BlobObject)
+		w, _ := encoded.Writer()
+		w.Write([]byte(content))
+		w.Close()
+		hash, err := repo.Storer.SetEncodedObject(encoded)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return hash
+	}
+	tree := func(entries ...object.TreeEntry) plumbing.Hash {
+		hash, err := storeObject(repo.Storer, &object.Tree{Entries: entries})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return hash
+	}
+	file := func(name string, hash plumbing.Hash) object.TreeEntry {
+		return object.TreeEntry{Name: name, Mode: filemode.Regular, Hash: hash}
+	}
+	dir := func(name string, hash plumbing.Hash) object.TreeEntry {
+		return object.TreeEntry{Name: name, Mode: filemode.Dir, Hash: hash}
+	}
+
+	docs := tree(file("guide.md", blob("guide")))
+	readme := blob("readme")
+	parentTree := tree(file("README.md", readme), dir("docs", docs), dir("src", tree(file("main.go", blob("v1")))))
+	childTree := tree(file("README.md", readme), dir("docs", docs), dir("src", tree(file("main.go", blob("v2")))))
+
+	when := time.Now()
+	root, err := storeCommit(repo.Storer, parentTree, nil, "root", when, when)
+	if err != nil {
+		t.Fatal(err)
+	}
+	child, err := storeCommit(repo.Storer, childTree, []plumbing.Hash{root}, "child", when, when)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		commit   plumbing.Hash
+		patterns []string
+		want     bool
+	}{
+		{name: "changed directory", commit: child, patterns: []string{"src"}, want: true},
+		{name: "changed file", commit: child, patterns: []string{"src/main.go"}, want: true},
+		{name: "unchanged directory", commit: child, patterns: []string{"docs"}, want: false},
+		{name: "missing path", commit: child, patterns: []string{"vendor"}, want: false},
+		{name: "glob under changed directory", commit: child, patterns: []string{"src/*.go"}, want: true},
+		{name: "glob misses changed file", commit: child, patterns: []string{"src/*.md"}, want: false},
+		{name: "glob under unchanged directory", commit: child, patterns: []string{"docs/*.md"}, want: false},
+		{name: "root glob", commit: child, patterns: []string{"*.go"}, want: true},
+		{name: "root glob miss", commit: child, patterns: []string{"README*"}, want: false},
+		{name: "any pattern", commit: child, patterns: []string{"docs", "src/"}, want: true},
+		{name: "root commit adds everything", commit: root, patterns: []string{"docs"}, want: true},
+		{name: "root commit glob", commit: root, patterns: []string{"docs/*.md"}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := repo.CommitObject(tt.commit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := commitTouchesPaths(c, tt.patterns, newPathFilter(tt.patterns)); got != tt.want {
+				t.Errorf("commitTouchesPaths(%q) = %v, want %v", tt.patterns, got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkGetCommitsLocal(b *testing.B) {
+	const (
+		commitCount = 3000
+		refCount    = 300
+	)
+
+	dir := b.TempDir()
+	if err := buildSyntheticRepo(dir, commitCount, refCount); err != nil {
+		b.Fatalf("failed to build synthetic repo: %v", err)
+	}
+
+	query := CommitQuery{Since: time.Now().AddDate(0, 0, -7)}
+
+	b.Run("shared-walk", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			commits, err := getCommitsLocal(context.Background(), dir, query)
+			if err != nil {
+				b.Fatalf("getCommitsLocal failed: %v", err)
+			}
+			if len(commits) == 0 {
+				b.Fatal("expected commits in the queried window")
+			}
+		}
+	})
+
+	b.Run("per-ref-log", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			commits, err := getCommitsPerRefLog(dir, query.Since)
+			if err != nil {
+				b.Fatalf("getCommitsPerRefLog failed: %v", err)
+			}
+			if len(commits) == 0 {
+				b.Fatal("expected commits in the queried window")
+			}
+		}
+	})
+}
+
+// getCommitsPerRefLog is the previous implementation, which logged every
+// branch separately and dropped the commits already seen.
+func getCommitsPerRefLog(repoPath string, since time.Time) ([]Commit, error) {
+	since = helpers.ToMidnight(since)
+
+	repo, err := git.PlainOpen(repoPath)
+	if err != nil {
+		return nil, err
+	}
+
+	refs, err := repo.References()
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[plumbing.Hash]bool)
+	var commits []Commit
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		if ref.Type() != plumbing.HashReference || !isBranchRef(ref.Name()) {
+			return nil
+		}
+
+		commitIter, err := repo.Log(&git.LogOptions{From: ref.Hash()})
+		if err != nil {
+			return nil
+		}
+		defer commitIter.Close()
+
+		return commitIter.ForEach(func(c *object.Commit) error {
+			if seen[c.Hash] || !c.Author.When.After(since) {
+				return nil
+			}
+			seen[c.Hash] = true
+			commits = append(commits, Commit{
+				Hash:      c.Hash.String(),
+				Branch:    ref.Name().Short(),
+				Author:    c.Author.Name,
+				Message:   c.Message,
+				Timestamp: c.Author.When,
+			})
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sortCommitsByTimestamp(commits)
+	return commits, nil
+}
+
+func buildSyntheticRepo(dir string, commitCount, refCount int) error {
+	repo, err := git.PlainInit(dir, false)
+	if err != nil {
+		return fmt.Errorf("failed to init repository: %w", err)
+	}
+
+	treeHash, err := storeObject(repo.Storer, &object.Tree{})
+	if err != nil {
+		return fmt.Errorf("failed to store tree: %w", err)
+	}
+
+	now := time.Now()
+	mainLine := make([]plumbing.Hash, commitCount)
+	var parent []plumbing.Hash
+	for i := 0; i < commitCount; i++ {
+		when := now.Add(-time.Duration(commitCount-i) * time.Hour)
+		hash, err := storeCommit(repo.Storer, treeHash, parent, fmt.Sprintf("main commit %d", i), when, when)
+		if err != nil {
+			return err
+		}
+		mainLine[i] = hash
+		parent = []plumbing.Hash{hash}
+	}
+
+	if err := repo.Storer.SetReference(plumbing.NewHashReference(plumbing.NewBranchReferenceName("main"), mainLine[commitCount-1])); err != nil {
+		return fmt.Errorf("failed to set main ref: %w", err)
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < refCount; i++ {
+		fork := rng.Intn(commitCount)
+		tip := mainLine[fork]
+		forkTime := now.Add(-time.Duration(commitCount-fork) * time.Hour)
+		for j := 0; j < 1+rng.Intn(3); j++ {
+			when := forkTime.Add(time.Duration(j+1) * time.Minute)
+			tip, err = storeCommit(repo.Storer, treeHash, []plumbing.Hash{tip}, fmt.Sprintf("feature %d commit %d", i, j), when, when)
+			if err != nil {
+				return err
+			}
+		}
+
+		name := plumbing.NewBranchReferenceName(fmt.Sprintf("feature-%d", i))
+		if err := repo.Storer.SetReference(plumbing.NewHashReference(name, tip)); err != nil {
+			return fmt.Errorf("failed to set ref %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func storeCommit(s storer.EncodedObjectStorer, tree plumbing.Hash, parents []plumbing.Hash, message string, authored, committed time.Time) (plumbing.Hash, error) {
+	hash, err := storeObject(s, &object.Commit{
+		Author:       object.Signature{Name: "Bench", Email: "bench@example.com", When: authored},
+		Committer:    object.Signature{Name: "Bench", Email: "bench@example.com", When: committed},
+		Message:      message,
+		TreeHash:     tree,
+		ParentHashes: parents,
+	})
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to store commit: %w", err)
+	}
+	return hash, nil
+}
+
+func storeObject(s storer.EncodedObjectStorer, o interface {
+	Encode(plumbing.EncodedObject) error
+}) (plumbing.Hash, error) {
+	encoded := s.NewEncodedObject()
+	if err := o.Encode(encoded); err != nil {
+		return plumbing.ZeroHash, err
+	}
+	return s.SetEncodedObject(encoded)
+}
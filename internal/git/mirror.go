@@ -0,0 +1,171 @@
+package git
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/go-git/go-git/v5"
+	gitconfig "github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+)
+
+type MirrorProvider struct {
+	token string
+}
+
+var (
+	mirrorLocksMu sync.Mutex
+	mirrorLocks   = make(map[string]*sync.Mutex)
+)
+
+var mirrorNameSanitizer = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+func NewMirrorProvider() *MirrorProvider {
+	return &MirrorProvider{}
+}
+
+func (p *MirrorProvider) SetToken(token string) {
+	p.token = token
+}
+
+func getMirrorCacheDir() (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get cache directory: %w", err)
+	}
+
+	return filepath.Join(cacheDir, "project-void", "mirrors"), nil
+}
+
+func ClearMirrors() error {
+	dir, err := getMirrorCacheDir()
+	if err != nil {
+		return err
+	}
+
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("failed to clear repository mirrors: %w", err)
+	}
+
+	return nil
+}
+
+func mirrorPath(repoURL string) (string, error) {
+	dir, err := getMirrorCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	name := strings.Trim(mirrorNameSanitizer.ReplaceAllString(getRepoHost(repoURL)+"-"+getRepoPath(repoURL), "-"), "-")
+	if len(name) > 60 {
+		name = name[len(name)-60:]
+	}
+	sum := sha256.Sum256([]byte(repoURL))
+
+	return filepath.Join(dir, name+"-"+hex.EncodeToString(sum[:])[:12]+".git"), nil
+}
+
+func mirrorLock(path string) *sync.Mutex {
+	mirrorLocksMu.Lock()
+	defer mirrorLocksMu.Unlock()
+
+	lock, ok := mirrorLocks[path]
+	if !ok {
+		lock = &sync.Mutex{}
+		mirrorLocks[path] = lock
+	}
+	return lock
+}
+
+func (p *MirrorProvider) auth(repoURL string) transport.AuthMethod {
+	if p.token == "" {
+		return nil
+	}
+	if !strings.HasPrefix(repoURL, "http://") && !strings.HasPrefix(repoURL, "https://") {
+		return nil
+	}
+	return &githttp.BasicAuth{Username: "git", Password: p.token}
+}
+
+func (p *MirrorProvider) sync(ctx context.Context, repoURL string) (string, error) {
+	path, err := mirrorPath(repoURL)
+	if err != nil {
+		return "", err
+	}
+
+	lock := mirrorLock(path)
+	lock.Lock()
+	defer lock.Unlock()
+
+	repo, err := git.PlainOpen(path)
+	if errors.Is(err, git.ErrRepositoryNotExists) {
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			return "", fmt.Errorf("failed to create mirror directory: %w", err)
+		}
+
+		repo, err = git.PlainInit(path, true)
+		if err != nil {
+			return "", fmt.Errorf("failed to create mirror: %w", err)
+		}
+
+		_, err = repo.CreateRemote(&gitconfig.RemoteConfig{
+			Name:  git.DefaultRemoteName,
+			URLs:  []string{repoURL},
+			Fetch: []gitconfig.RefSpec{"+refs/heads/*:refs/heads/*"},
+		})
+		if err != nil {
+			os.RemoveAll(path)
+			return "", fmt.Errorf("failed to configure mirror remote: %w", err)
+		}
+	} else if err != nil {
+		return "", fmt.Errorf("failed to open mirror: %w", err)
+	}
+
+	err = repo.FetchContext(ctx, &git.FetchOptions{
+		RemoteName: git.DefaultRemoteName,
+		Auth:       p.auth(repoURL),
+		Tags:       git.NoTags,
+		Force:      true,
+		Prune:      true,
+	})
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return "", fmt.Errorf("failed to fetch mirror of %s: %w", repoURL, err)
+	}
+
+	return path, nil
+}
+
+func (p *MirrorProvider) Query(ctx context.Context, repoURL string, query CommitQuery) ([]Commit, error) {
+	path, err := p.sync(ctx, repoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return getCommitsLocal(ctx, path, query)
+}
+
+func (p *MirrorProvider) CommitFiles(ctx context.Context, repoURL, hash string) ([]FileChange, error) {
+	path, err := mirrorPath(repoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := getCommitFilesLocal(path, hash)
+	if err == nil {
+		return files, nil
+	}
+
+	if path, err = p.sync(ctx, repoURL); err != nil {
+		return nil, err
+	}
+	return getCommitFilesLocal(path, hash)
+}
@@ -0,0 +1,183 @@
+package git
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"slices"
+	"sort"
+	"sync"
+)
+
+const DefaultConcurrency = 4
+
+type requestLimiter struct {
+	limit int
+	mu    sync.Mutex
+	hosts map[string]chan struct{}
+}
+
+type requestLimiterKey struct{}
+
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func withRequestLimit(ctx context.Context, limit int) context.Context {
+	if limit < 1 {
+		limit = 1
+	}
+	return context.WithValue(ctx, requestLimiterKey{}, &requestLimiter{limit: limit, hosts: make(map[string]chan struct{})})
+}
+
+func (l *requestLimiter) slots(host string) chan struct{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	slots, ok := l.hosts[host]
+	if !ok {
+		slots = make(chan struct{}, l.limit)
+		l.hosts[host] = slots
+	}
+	return slots
+}
+
+func acquireRequestSlot(ctx context.Context, host string) (func(), error) {
+	limiter, ok := ctx.Value(requestLimiterKey{}).(*requestLimiter)
+	if !ok {
+		return func() {}, nil
+	}
+
+	slots := limiter.slots(host)
+	select {
+	case slots <- struct{}{}:
+		return sync.OnceFunc(func() { <-slots }), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func doLimited(client *http.Client, req *http.Request) (*http.Response, error) {
+	release, err := acquireRequestSlot(req.Context(), req.URL.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+func (b *releasingBody) Close() error {
+	defer b.release()
+	return b.ReadCloser.Close()
+}
+
+func runLimited(ctx context.Context, limit, n int, task func(ctx context.Context, i int) error) error {
+	if limit < 1 {
+		limit = 1
+	}
+
+	poolCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sem := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+
+	for i := 0; i < n; i++ {
+		select {
+		case sem <- struct{}{}:
+		case <-poolCtx.Done():
+		}
+		if poolCtx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := task(poolCtx, i); err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+func mergeBranchCommits(perBranch [][]Commit) []Commit {
+	seen := make(map[string]int)
+
+	var result []Commit
+	for _, commits := range perBranch {
+		for _, commit := range commits {
+			if i, ok := seen[commit.Hash]; ok {
+				if commit.Branch != "" && !slices.Contains(result[i].Branches, commit.Branch) {
+					result[i].Branches = append(result[i].Branches, commit.Branch)
+				}
+				continue
+			}
+			seen[commit.Hash] = len(result)
+			if commit.Branch != "" {
+				commit.Branches = []string{commit.Branch}
+			}
+			result = append(result, commit)
+		}
+	}
+
+	mainline := make(map[string]string)
+	for _, commits := range perBranch {
+		if len(commits) == 0 || !isDefaultBranch(commits[0].Branch) {
+			continue
+		}
+
+		byHash := make(map[string]Commit, len(commits))
+		for _, commit := range commits {
+			byHash[commit.Hash] = commit
+		}
+
+		commit := commits[0]
+		for {
+			if _, marked := mainline[commit.Hash]; !marked {
+				mainline[commit.Hash] = commit.Branch
+			}
+			if len(commit.Parents) == 0 {
+				break
+			}
+			parent, ok := byHash[commit.Parents[0]]
+			if !ok {
+				break
+			}
+			commit = parent
+		}
+	}
+
+	for i := range result {
+		if len(result[i].Branches) > 1 {
+			sort.Strings(result[i].Branches)
+			result[i].Branch = primaryBranch(result[i].Hash, result[i].Branches, mainline)
+		}
+	}
+
+	sortCommitsByTimestamp(result)
+
+	return result
+}
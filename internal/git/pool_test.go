@@ -0,0 +1,167 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRequestLimitPerHost(t *testing.T) {
+	const (
+		limit    = 2
+		requests = 8
+	)
+
+	newServer := func(maxInFlight *int) *httptest.Server {
+		var mu sync.Mutex
+		inFlight := 0
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			inFlight++
+			*maxInFlight = max(*maxInFlight, inFlight)
+			mu.Unlock()
+
+			time.Sleep(20 * time.Millisecond)
+
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+			io.WriteString(w, "ok")
+		}))
+	}
+
+	var maxA, maxB int
+	serverA, serverB := newServer(&maxA), newServer(&maxB)
+	defer serverA.Close()
+	defer serverB.Close()
+
+	ctx := withRequestLimit(context.Background(), limit)
+	client := &http.Client{}
+
+	var wg sync.WaitGroup
+	errs := make([]error, 2*requests)
+	for i := range errs {
+		url := serverA.URL
+		if i%2 == 1 {
+			url = serverB.URL
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			resp, err := doLimited(client, req)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			io.Copy(io.Discard, resp.Body)
+			errs[i] = resp.Body.Close()
+		}(i)
+	}
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		t.Fatalf("requests failed: %v", err)
+	}
+
+	serverA.Close()
+	serverB.Close()
+	for name, got := range map[string]int{"A": maxA, "B": maxB} {
+		if got < 1 || got > limit {
+			t.Errorf("host %s saw %d requests in flight, want between 1 and %d", name, got, limit)
+		}
+	}
+}
+
+func TestAcquireRequestSlotCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(withRequestLimit(context.Background(), 1))
+
+	release, err := acquireRequestSlot(ctx, "example.com")
+	if err != nil {
+		t.Fatalf("first slot: %v", err)
+	}
+	defer release()
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := acquireRequestSlot(ctx, "example.com")
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		t.Fatalf("queued request got a slot while the host was busy: %v", err)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("queued request err = %v, want %v", err, context.Canceled)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("queued request was not released by cancellation")
+	}
+}
+
+func TestRunLimited(t *testing.T) {
+	errTask := errors.New("task failed")
+
+	tests := []struct {
+		name        string
+		limit       int
+		n           int
+		failAt      int
+		cancelAt    int
+		wantStarted []int
+		wantErr     error
+	}{
+		{name: "runs every task", limit: 2, n: 5, failAt: -1, cancelAt: -1, wantStarted: []int{0, 1, 2, 3, 4}},
+		{name: "zero limit runs one at a time", limit: 0, n: 3, failAt: -1, cancelAt: -1, wantStarted: []int{0, 1, 2}},
+		{name: "error stops queued tasks", limit: 1, n: 5, failAt: 2, cancelAt: -1, wantStarted: []int{0, 1, 2}, wantErr: errTask},
+		{name: "cancellation stops queued tasks", limit: 1, n: 5, failAt: -1, cancelAt: 1, wantStarted: []int{0, 1}, wantErr: context.Canceled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			var mu sync.Mutex
+			var started []int
+			err := runLimited(ctx, tt.limit, tt.n, func(ctx context.Context, i int) error {
+				mu.Lock()
+				started = append(started, i)
+				mu.Unlock()
+
+				switch i {
+				case tt.failAt:
+					return errTask
+				case tt.cancelAt:
+					cancel()
+				}
+				return nil
+			})
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("runLimited() err = %v, want %v", err, tt.wantErr)
+			}
+			slices.Sort(started)
+			if !slices.Equal(started, tt.wantStarted) {
+				t.Errorf("started tasks = %v, want %v", started, tt.wantStarted)
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package git
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type RateLimitStatus struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+	Known     bool
+}
+
+type RateLimitError struct {
+	Host  string
+	Reset time.Time
+}
+
+func (e *RateLimitError) Error() string {
+	if e.Reset.IsZero() {
+		return fmt.Sprintf("%s rate limit exceeded", e.Host)
+	}
+	return fmt.Sprintf("%s rate limit exhausted, resets at %s", e.Host, e.Reset.Local().Format("15:04:05"))
+}
+
+type rateLimitTransport struct {
+	base       http.RoundTripper
+	maxRetries int
+	maxWait    time.Duration
+}
+
+const (
+	gitHubAPIHost          = "api.github.com"
+	secondaryRateLimitWait = time.Minute
+)
+
+var (
+	rateLimitMu     sync.Mutex
+	rateLimitStates = make(map[string]RateLimitStatus)
+)
+
+func newRateLimitTransport() *rateLimitTransport {
+	base := http.DefaultTransport.(*http.Transport).Clone()
+	base.ResponseHeaderTimeout = 30 * time.Second
+
+	return &rateLimitTransport{
+		base:       base,
+		maxRetries: 3,
+		maxWait:    2 * time.Minute,
+	}
+}
+
+func IsRateLimited(err error) bool {
+	var rateLimitErr *RateLimitError
+	return errors.As(err, &rateLimitErr)
+}
+
+func GetRateLimitStatus(host string) (RateLimitStatus, bool) {
+	rateLimitMu.Lock()
+	defer rateLimitMu.Unlock()
+
+	status, ok := rateLimitStates[host]
+	return status, ok
+}
+
+func GitHubRateLimit() RateLimitStatus {
+	status, _ := GetRateLimitStatus(gitHubAPIHost)
+	return status
+}
+
+func (s RateLimitStatus) String() string {
+	if !s.Known {
+		return "unknown"
+	}
+	return fmt.Sprintf("%d/%d requests left, resets at %s", s.Remaining, s.Limit, s.Reset.Local().Format("15:04"))
+}
+
+func recordRateLimit(host string, header http.Header) {
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+
+	status := RateLimitStatus{Remaining: remaining, Known: true}
+	if limit, err := strconv.Atoi(header.Get("X-RateLimit-Limit")); err == nil {
+		status.Limit = limit
+	}
+	if reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		status.Reset = time.Unix(reset, 0)
+	}
+
+	rateLimitMu.Lock()
+	rateLimitStates[host] = status
+	rateLimitMu.Unlock()
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	host := req.URL.Host
+
+	if status, ok := GetRateLimitStatus(host); ok && status.Remaining == 0 && time.Now().Before(status.Reset) {
+		wait := time.Until(status.Reset)
+		if wait > t.maxWait {
+			return nil, &RateLimitError{Host: host, Reset: status.Reset}
+		}
+		if err := sleepContext(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+
+	backoff := time.Second
+	for attempt := 0; ; attempt++ {
+		resp, err := t.base.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+
+		recordRateLimit(host, resp.Header)
+
+		wait, rateLimited, retryable := retryDelay(resp, backoff)
+		if !retryable || attempt >= t.maxRetries {
+			if rateLimited {
+				resp.Body.Close()
+				status, _ := GetRateLimitStatus(host)
+				return nil, &RateLimitError{Host: host, Reset: status.Reset}
+			}
+			return resp, nil
+		}
+
+		if wait > t.maxWait {
+			resp.Body.Close()
+			return nil, &RateLimitError{Host: host, Reset: time.Now().Add(wait)}
+		}
+
+		resp.Body.Close()
+		if err := sleepContext(req.Context(), wait); err != nil {
+			return nil, err
+		}
+		backoff *= 2
+	}
+}
+
+func retryDelay(resp *http.Response, backoff time.Duration) (wait time.Duration, rateLimited bool, retryable bool) {
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusForbidden:
+		if retryAfter, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+			return time.Duration(retryAfter) * time.Second, true, true
+		}
+
+		if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+			reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+			if err != nil {
+				return 0, true, false
+			}
+			return time.Until(time.Unix(reset, 0)) + time.Second, true, true
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests || isSecondaryRateLimit(resp) {
+			if backoff < secondaryRateLimitWait {
+				backoff = secondaryRateLimitWait
+			}
+			return backoff, true, true
+		}
+
+		return 0, false, false
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return backoff, false, true
+	}
+
+	return 0, false, false
+}
+
+func isSecondaryRateLimit(resp *http.Response) bool {
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return false
+	}
+
+	return strings.Contains(strings.ToLower(string(body)), "secondary rate limit")
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,135 @@
+package git
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetryDelay(t *testing.T) {
+	resetIn90s := strconv.FormatInt(time.Now().Add(90*time.Second).Unix(), 10)
+
+	tests := []struct {
+		name          string
+		status        int
+		header        map[string]string
+		body          string
+		backoff       time.Duration
+		wantWait      time.Duration
+		tolerance     time.Duration
+		wantLimited   bool
+		wantRetryable bool
+	}{
+		{
+			name:          "retry-after on 429",
+			status:        http.StatusTooManyRequests,
+			header:        map[string]string{"Retry-After": "30"},
+			backoff:       time.Second,
+			wantWait:      30 * time.Second,
+			wantLimited:   true,
+			wantRetryable: true,
+		},
+		{
+			name:          "retry-after wins over reset",
+			status:        http.StatusForbidden,
+			header:        map[string]string{"Retry-After": "5", "X-RateLimit-Remaining": "0", "X-RateLimit-Reset": resetIn90s},
+			backoff:       time.Second,
+			wantWait:      5 * time.Second,
+			wantLimited:   true,
+			wantRetryable: true,
+		},
+		{
+			name:          "exhausted quota waits for reset",
+			status:        http.StatusForbidden,
+			header:        map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": resetIn90s},
+			backoff:       time.Second,
+			wantWait:      91 * time.Second,
+			tolerance:     2 * time.Second,
+			wantLimited:   true,
+			wantRetryable: true,
+		},
+		{
+			name:          "exhausted quota without reset",
+			status:        http.StatusForbidden,
+			header:        map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "soon"},
+			backoff:       time.Second,
+			wantLimited:   true,
+			wantRetryable: false,
+		},
+		{
+			name:          "invalid retry-after falls through to reset",
+			status:        http.StatusTooManyRequests,
+			header:        map[string]string{"Retry-After": "later", "X-RateLimit-Remaining": "0", "X-RateLimit-Reset": resetIn90s},
+			backoff:       time.Second,
+			wantWait:      91 * time.Second,
+			tolerance:     2 * time.Second,
+			wantLimited:   true,
+			wantRetryable: true,
+		},
+		{
+			name:          "secondary rate limit waits at least a minute",
+			status:        http.StatusForbidden,
+			body:          `{"message":"You have exceeded a secondary rate limit."}`,
+			backoff:       time.Second,
+			wantWait:      secondaryRateLimitWait,
+			wantLimited:   true,
+			wantRetryable: true,
+		},
+		{
+			name:          "429 without headers keeps longer backoff",
+			status:        http.StatusTooManyRequests,
+			backoff:       2 * time.Minute,
+			wantWait:      2 * time.Minute,
+			wantLimited:   true,
+			wantRetryable: true,
+		},
+		{
+			name:    "plain forbidden is not retried",
+			status:  http.StatusForbidden,
+			header:  map[string]string{"X-RateLimit-Remaining": "42"},
+			body:    `{"message":"Resource not accessible by integration"}`,
+			backoff: time.Second,
+		},
+		{
+			name:          "server error backs off",
+			status:        http.StatusServiceUnavailable,
+			backoff:       4 * time.Second,
+			wantWait:      4 * time.Second,
+			wantRetryable: true,
+		},
+		{
+			name:    "success",
+			status:  http.StatusOK,
+			backoff: time.Second,
+		},
+		{
+			name:    "not found",
+			status:  http.StatusNotFound,
+			backoff: time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{
+				StatusCode: tt.status,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(tt.body)),
+			}
+			for name, value := range tt.header {
+				resp.Header.Set(name, value)
+			}
+
+			wait, limited, retryable := retryDelay(resp, tt.backoff)
+			if limited != tt.wantLimited || retryable != tt.wantRetryable {
+				t.Fatalf("retryDelay() limited, retryable = %v, %v, want %v, %v", limited, retryable, tt.wantLimited, tt.wantRetryable)
+			}
+			if diff := wait - tt.wantWait; diff < -tt.tolerance || diff > tt.tolerance {
+				t.Errorf("retryDelay() wait = %s, want %s (±%s)", wait, tt.wantWait, tt.tolerance)
+			}
+		})
+	}
+}
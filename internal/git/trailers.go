@@ -0,0 +1,65 @@
+package git
+
+import (
+	"strings"
+)
+
+type CoAuthor struct {
+	Name  string
+	Email string
+}
+
+var coAuthorTrailers = []string{"co-authored-by:", "signed-off-by:"}
+
+func parseCoAuthors(message, author string) []CoAuthor {
+	var coAuthors []CoAuthor
+	seen := map[string]bool{strings.ToLower(strings.TrimSpace(author)): true}
+
+	for _, line := range strings.Split(message, "\n") {
+		line = strings.TrimSpace(line)
+		lower := strings.ToLower(line)
+
+		for _, trailer := range coAuthorTrailers {
+			if !strings.HasPrefix(lower, trailer) {
+				continue
+			}
+
+			coAuthor := parseTrailerIdentity(strings.TrimSpace(line[len(trailer):]))
+			key := strings.ToLower(coAuthor.Name)
+			if coAuthor.Name != "" && !seen[key] {
+				seen[key] = true
+				coAuthors = append(coAuthors, coAuthor)
+			}
+			break
+		}
+	}
+
+	return coAuthors
+}
+
+func parseTrailerIdentity(value string) CoAuthor {
+	start := strings.Index(value, "<")
+	end := strings.LastIndex(value, ">")
+	if start < 0 {
+		return CoAuthor{Name: value}
+	}
+	if end < start {
+		return CoAuthor{Name: strings.TrimSpace(value[:start])}
+	}
+
+	name := strings.TrimSpace(value[:start])
+	email := strings.TrimSpace(value[start+1 : end])
+	if name == "" {
+		name = email
+	}
+
+	return CoAuthor{Name: name, Email: email}
+}
+
+func annotateCoAuthors(commits []Commit) {
+	for i := range commits {
+		if commits[i].CoAuthors == nil {
+			commits[i].CoAuthors = parseCoAuthors(commits[i].Message, commits[i].Author)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package git
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseCoAuthors(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		author  string
+		want    []CoAuthor
+	}{
+		{
+			name:    "no trailers",
+			message: "fix: handle empty input",
+			author:  "Jane Doe",
+			want:    nil,
+		},
+		{
+			name:    "co-author with email",
+			message: "feat: add login\n\nCo-authored-by: John Smith <john@example.com>",
+			author:  "Jane Doe",
+			want:    []CoAuthor{{Name: "John Smith", Email: "john@example.com"}},
+		},
+		{
+			name:    "case-insensitive keys",
+			message: "feat: add login\n\nCO-AUTHORED-BY: John Smith <john@example.com>\nsigned-off-by: Ann Lee <ann@example.com>",
+			author:  "Jane Doe",
+			want:    []CoAuthor{{Name: "John Smith", Email: "john@example.com"}, {Name: "Ann Lee", Email: "ann@example.com"}},
+		},
+		{
+			name:    "indented trailer without space after colon",
+			message: "chore: bump\n\n   Co-Authored-By:John Smith <john@example.com>",
+			author:  "Jane Doe",
+			want:    []CoAuthor{{Name: "John Smith", Email: "john@example.com"}},
+		},
+		{
+			name:    "duplicates collapse ignoring case",
+			message: "fix: race\n\nCo-authored-by: John Smith <john@example.com>\nSigned-off-by: john smith <john@work.example>\nCo-authored-by: John Smith <john@example.com>",
+			author:  "Jane Doe",
+			want:    []CoAuthor{{Name: "John Smith", Email: "john@example.com"}},
+		},
+		{
+			name:    "commit author is not a co-author",
+			message: "fix: race\n\nSigned-off-by: Jane Doe <jane@example.com>",
+			author:  "jane doe",
+			want:    nil,
+		},
+		{
+			name:    "email only uses email as name",
+			message: "fix: race\n\nCo-authored-by: <john@example.com>",
+			author:  "Jane Doe",
+			want:    []CoAuthor{{Name: "john@example.com", Email: "john@example.com"}},
+		},
+		{
+			name:    "name without email",
+			message: "fix: race\n\nCo-authored-by: John Smith",
+			author:  "Jane Doe",
+			want:    []CoAuthor{{Name: "John Smith"}},
+		},
+		{
+			name:    "unterminated email keeps the name",
+			message: "fix: race\n\nCo-authored-by: John Smith <john@example.com",
+			author:  "Jane Doe",
+			want:    []CoAuthor{{Name: "John Smith"}},
+		},
+		{
+			name:    "empty trailer is ignored",
+			message: "fix: race\n\nCo-authored-by:\nCo-authored-by:   <>",
+			author:  "Jane Doe",
+			want:    nil,
+		},
+		{
+			name:    "missing colon is ignored",
+			message: "fix: race\n\nCo-authored-by John Smith <john@example.com>",
+			author:  "Jane Doe",
+			want:    nil,
+		},
+		{
+			name:    "trailer key mid-line is ignored",
+			message: "fix: race\n\nThanks to Co-authored-by: John Smith",
+			author:  "Jane Doe",
+			want:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseCoAuthors(tt.message, tt.author); !slices.Equal(got, tt.want) {
+				t.Errorf("parseCoAuthors(%q, %q) = %+v, want %+v", tt.message, tt.author, got, tt.want)
+			}
+		})
+	}
+}
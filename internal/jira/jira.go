@@ -1,6 +1,7 @@
 package jira
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -39,10 +40,13 @@ func NewJiraClient(baseURL, username, apiToken string) *JiraClient {
 	}
 }
 
-func (c *JiraClient) GetIssuesSince(since time.Time, config *JiraConfig) ([]Issue, error) {
+func (c *JiraClient) GetIssuesSince(ctx context.Context, since, until time.Time, config *JiraConfig) ([]Issue, error) {
 	sinceStr := since.Format("2006-01-02")
 
 	jql := fmt.Sprintf("updated >= '%s'", sinceStr)
+	if !until.IsZero() {
+		jql = fmt.Sprintf("%s AND updated < '%s'", jql, until.AddDate(0, 0, 1).Format("2006-01-02"))
+	}
 
 	if len(config.ProjectKeys) > 0 {
 		if len(config.ProjectKeys) == 1 {
@@ -94,7 +98,7 @@ func (c *JiraClient) GetIssuesSince(since time.Time, config *JiraConfig) ([]Issu
 		url := fmt.Sprintf("%s/rest/api/2/search?jql=%s&startAt=%d&maxResults=%d",
 			c.BaseURL, url.QueryEscape(jql), startAt, maxResults)
 
-		req, err := http.NewRequest("GET", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create request: %w", err)
 		}
@@ -160,6 +160,26 @@ func (h CommandHandler) processCommand() (CommandHandler, tea.Cmd, *CommandResul
 		}
 	}
 
+	if command.Action == "void_set_range" {
+		fromDate, toDate, err := commands.GetDateRangeFromCommand(command.Name)
+		if err != nil {
+			h.commandError = fmt.Sprintf("Error parsing date range: %v", err)
+			h.successMessage = ""
+			h.textInput.SetValue("")
+			return h, nil, nil
+		}
+
+		h.commandError = ""
+		h.successMessage = fmt.Sprintf("✓ Analysis range set to: %s – %s", fromDate.Format("January 2, 2006"), toDate.Format("January 2, 2006"))
+		h.textInput.SetValue("")
+		return h, nil, &CommandResult{
+			Action:  "void_set_range",
+			Success: true,
+			Message: h.successMessage,
+			Data:    map[string]interface{}{"date": fromDate, "until": toDate},
+		}
+	}
+
 	h.commandError = ""
 	h.successMessage = ""
 	h.textInput.SetValue("")
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"project-void/internal/commands"
 	"project-void/internal/config"
+	"project-void/internal/git"
 	"strings"
 )
 
@@ -30,9 +31,16 @@ func (h CommandHandler) handleGitCommands(cmd commands.Command) *CommandResult {
 		var status strings.Builder
 		status.WriteString(fmt.Sprintf("Git Repositories (%d configured):\n", len(gitConfig.Git.RepoURLs)))
 		for i, repo := range gitConfig.Git.RepoURLs {
-			status.WriteString(fmt.Sprintf("  %d. %s\n", i+1, repo))
+			if provider, ok := gitConfig.Git.RepoProviders[repo]; ok {
+				status.WriteString(fmt.Sprintf("  %d. %s [%s]\n", i+1, repo, provider))
+			} else {
+				status.WriteString(fmt.Sprintf("  %d. %s\n", i+1, repo))
+			}
 		}
 		status.WriteString(fmt.Sprintf("Type: %s", gitConfig.Git.RepoType))
+		if git.HasGitHubRepos(gitConfig.Git.RepoURLs) {
+			status.WriteString(fmt.Sprintf("\nGitHub API: %s", git.GetGitHubRateLimitSummary(gitConfig.Git.GitHubToken)))
+		}
 
 		return &CommandResult{
 			Action:  "git_status",
@@ -77,7 +85,7 @@ func (h CommandHandler) handleGitCommands(cmd commands.Command) *CommandResult {
 		}
 
 		repoType := "local"
-		if strings.HasPrefix(value, "http://") || strings.HasPrefix(value, "https://") || strings.HasPrefix(value, "git@") {
+		if strings.HasPrefix(value, "http://") || strings.HasPrefix(value, "https://") || strings.HasPrefix(value, "git@") || strings.HasPrefix(value, "ssh://") || strings.HasPrefix(value, "git://") || strings.HasPrefix(value, "file://") {
 			repoType = "remote"
 		}
 
@@ -179,6 +187,400 @@ func (h CommandHandler) handleGitCommands(cmd commands.Command) *CommandResult {
 			Success: true,
 			Message: fmt.Sprintf("✓ GitHub API token set: %s\nRate limit increased from 60 to 5,000 requests per hour!", maskedToken),
 		}
+
+	case "git_set_provider_token":
+		provider, value := commands.GetProviderTokenValue(cmd.Name)
+		if provider == "" || value == "" {
+			return &CommandResult{
+				Action:  "git_set_provider_token",
+				Success: false,
+				Message: "Invalid git token command",
+			}
+		}
+
+		err := config.SetGitConfig(provider+"_token", value)
+		if err != nil {
+			return &CommandResult{
+				Action:  "git_set_provider_token",
+				Success: false,
+				Message: fmt.Sprintf("Failed to set %s token: %v", provider, err),
+			}
+		}
+
+		maskedToken := value
+		if len(maskedToken) > 8 {
+			maskedToken = maskedToken[:4] + "..." + maskedToken[len(maskedToken)-4:]
+		}
+
+		return &CommandResult{
+			Action:  "git_set_provider_token",
+			Success: true,
+			Message: fmt.Sprintf("✓ %s API token set: %s", provider, maskedToken),
+		}
+
+	case "git_set_repo_token":
+		repoURL, value := commands.GetRepoTokenValue(cmd.Name)
+		if repoURL == "" || value == "" {
+			return &CommandResult{
+				Action:  "git_set_repo_token",
+				Success: false,
+				Message: "Invalid git repo token command",
+			}
+		}
+
+		err := config.SetGitRepoToken(repoURL, value)
+		if err != nil {
+			return &CommandResult{
+				Action:  "git_set_repo_token",
+				Success: false,
+				Message: fmt.Sprintf("Failed to set repository token: %v", err),
+			}
+		}
+
+		maskedToken := value
+		if len(maskedToken) > 8 {
+			maskedToken = maskedToken[:4] + "..." + maskedToken[len(maskedToken)-4:]
+		}
+
+		return &CommandResult{
+			Action:  "git_set_repo_token",
+			Success: true,
+			Message: fmt.Sprintf("✓ Token for %s set: %s", repoURL, maskedToken),
+		}
+
+	case "git_set_max_pages":
+		key, value := commands.GetGitConfigValue(cmd.Name)
+		if key == "" || value == "" {
+			return &CommandResult{
+				Action:  "git_set_max_pages",
+				Success: false,
+				Message: "Invalid git pages command",
+			}
+		}
+
+		err := config.SetGitConfig("max_pages", value)
+		if err != nil {
+			return &CommandResult{
+				Action:  "git_set_max_pages",
+				Success: false,
+				Message: fmt.Sprintf("Failed to set page cap: %v", err),
+			}
+		}
+
+		return &CommandResult{
+			Action:  "git_set_max_pages",
+			Success: true,
+			Message: fmt.Sprintf("✓ GitHub page cap set to %s pages per branch", value),
+		}
+
+	case "git_set_concurrency":
+		key, value := commands.GetGitConfigValue(cmd.Name)
+		if key == "" || value == "" {
+			return &CommandResult{
+				Action:  "git_set_concurrency",
+				Success: false,
+				Message: "Invalid git concurrency command",
+			}
+		}
+
+		err := config.SetGitConfig("concurrency", value)
+		if err != nil {
+			return &CommandResult{
+				Action:  "git_set_concurrency",
+				Success: false,
+				Message: fmt.Sprintf("Failed to set concurrency limit: %v", err),
+			}
+		}
+
+		return &CommandResult{
+			Action:  "git_set_concurrency",
+			Success: true,
+			Message: fmt.Sprintf("✓ Concurrency limit set to %s parallel requests per host", value),
+		}
+
+	case "git_clear_cache":
+		err := git.ClearHTTPCache()
+		if err != nil {
+			return &CommandResult{
+				Action:  "git_clear_cache",
+				Success: false,
+				Message: fmt.Sprintf("Failed to clear cache: %v", err),
+			}
+		}
+
+		return &CommandResult{
+			Action:  "git_clear_cache",
+			Success: true,
+			Message: "✓ GitHub API response cache cleared",
+		}
+
+	case "git_stats_on":
+		err := config.SetGitConfig("stats", "true")
+		if err != nil {
+			return &CommandResult{
+				Action:  "git_stats_on",
+				Success: false,
+				Message: fmt.Sprintf("Failed to enable diff stats: %v", err),
+			}
+		}
+		return &CommandResult{
+			Action:  "git_stats_on",
+			Success: true,
+			Message: "✓ Diff stats enabled - showing lines added/removed per commit",
+		}
+
+	case "git_stats_off":
+		err := config.SetGitConfig("stats", "false")
+		if err != nil {
+			return &CommandResult{
+				Action:  "git_stats_off",
+				Success: false,
+				Message: fmt.Sprintf("Failed to disable diff stats: %v", err),
+			}
+		}
+		return &CommandResult{
+			Action:  "git_stats_off",
+			Success: true,
+			Message: "✓ Diff stats disabled",
+		}
+
+	case "git_coauthors_on":
+		err := config.SetGitConfig("coauthors", "true")
+		if err != nil {
+			return &CommandResult{
+				Action:  "git_coauthors_on",
+				Success: false,
+				Message: fmt.Sprintf("Failed to enable co-author matching: %v", err),
+			}
+		}
+		return &CommandResult{
+			Action:  "git_coauthors_on",
+			Success: true,
+			Message: "✓ Author filter now matches Co-authored-by and Signed-off-by trailers",
+		}
+
+	case "git_coauthors_off":
+		err := config.SetGitConfig("coauthors", "false")
+		if err != nil {
+			return &CommandResult{
+				Action:  "git_coauthors_off",
+				Success: false,
+				Message: fmt.Sprintf("Failed to disable co-author matching: %v", err),
+			}
+		}
+		return &CommandResult{
+			Action:  "git_coauthors_off",
+			Success: true,
+			Message: "✓ Author filter now matches the commit author only",
+		}
+
+	case "git_clear_mirrors":
+		err := git.ClearMirrors()
+		if err != nil {
+			return &CommandResult{
+				Action:  "git_clear_mirrors",
+				Success: false,
+				Message: fmt.Sprintf("Failed to clear mirrors: %v", err),
+			}
+		}
+
+		return &CommandResult{
+			Action:  "git_clear_mirrors",
+			Success: true,
+			Message: "✓ Repository mirrors cleared",
+		}
+
+	case "git_list_aliases":
+		status, err := git.GetGitAliasesStatus()
+		if err != nil {
+			return &CommandResult{
+				Action:  "git_list_aliases",
+				Success: false,
+				Message: fmt.Sprintf("Failed to load author aliases: %v", err),
+			}
+		}
+
+		return &CommandResult{
+			Action:  "git_list_aliases",
+			Success: true,
+			Message: status,
+		}
+
+	case "git_set_alias":
+		alias, canonical := commands.GetAliasValue(cmd.Name)
+		if alias == "" || canonical == "" {
+			return &CommandResult{
+				Action:  "git_set_alias",
+				Success: false,
+				Message: "Invalid git alias command",
+			}
+		}
+
+		err := config.SetGitAuthorAlias(alias, canonical)
+		if err != nil {
+			return &CommandResult{
+				Action:  "git_set_alias",
+				Success: false,
+				Message: fmt.Sprintf("Failed to set author alias: %v", err),
+			}
+		}
+
+		return &CommandResult{
+			Action:  "git_set_alias",
+			Success: true,
+			Message: fmt.Sprintf("✓ %s is now shown as %s", alias, canonical),
+		}
+
+	case "git_remove_alias":
+		alias := commands.GetAliasRemoveValue(cmd.Name)
+		if alias == "" {
+			return &CommandResult{
+				Action:  "git_remove_alias",
+				Success: false,
+				Message: "Invalid git alias remove command",
+			}
+		}
+
+		err := config.RemoveGitAuthorAlias(alias)
+		if err != nil {
+			return &CommandResult{
+				Action:  "git_remove_alias",
+				Success: false,
+				Message: fmt.Sprintf("Failed to remove author alias: %v", err),
+			}
+		}
+
+		return &CommandResult{
+			Action:  "git_remove_alias",
+			Success: true,
+			Message: fmt.Sprintf("✓ Author alias removed: %s", alias),
+		}
+
+	case "git_list_hosts":
+		status, err := git.GetGitHostsStatus()
+		if err != nil {
+			return &CommandResult{
+				Action:  "git_list_hosts",
+				Success: false,
+				Message: fmt.Sprintf("Failed to load Git hosts: %v", err),
+			}
+		}
+
+		return &CommandResult{
+			Action:  "git_list_hosts",
+			Success: true,
+			Message: status,
+		}
+
+	case "git_set_host":
+		host, provider, apiURL := commands.GetHostValue(cmd.Name)
+		if host == "" || provider == "" {
+			return &CommandResult{
+				Action:  "git_set_host",
+				Success: false,
+				Message: "Invalid git host command",
+			}
+		}
+
+		err := config.SetGitHost(host, provider, apiURL)
+		if err != nil {
+			return &CommandResult{
+				Action:  "git_set_host",
+				Success: false,
+				Message: fmt.Sprintf("Failed to register Git host: %v", err),
+			}
+		}
+
+		message := fmt.Sprintf("✓ Host %s registered as %s", config.NormalizeGitHost(host), provider)
+		if apiURL != "" {
+			message += fmt.Sprintf(" (API: %s)", apiURL)
+		}
+
+		return &CommandResult{
+			Action:  "git_set_host",
+			Success: true,
+			Message: message,
+		}
+
+	case "git_set_host_token":
+		host, value := commands.GetHostTokenValue(cmd.Name)
+		if host == "" || value == "" {
+			return &CommandResult{
+				Action:  "git_set_host_token",
+				Success: false,
+				Message: "Invalid git host token command",
+			}
+		}
+
+		err := config.SetGitHostToken(host, value)
+		if err != nil {
+			return &CommandResult{
+				Action:  "git_set_host_token",
+				Success: false,
+				Message: fmt.Sprintf("Failed to set host token: %v", err),
+			}
+		}
+
+		maskedToken := value
+		if len(maskedToken) > 8 {
+			maskedToken = maskedToken[:4] + "..." + maskedToken[len(maskedToken)-4:]
+		}
+
+		return &CommandResult{
+			Action:  "git_set_host_token",
+			Success: true,
+			Message: fmt.Sprintf("✓ Token for host %s set: %s", config.NormalizeGitHost(host), maskedToken),
+		}
+
+	case "git_remove_host":
+		host := commands.GetHostRemoveValue(cmd.Name)
+		if host == "" {
+			return &CommandResult{
+				Action:  "git_remove_host",
+				Success: false,
+				Message: "Invalid git host remove command",
+			}
+		}
+
+		err := config.RemoveGitHost(host)
+		if err != nil {
+			return &CommandResult{
+				Action:  "git_remove_host",
+				Success: false,
+				Message: fmt.Sprintf("Failed to remove Git host: %v", err),
+			}
+		}
+
+		return &CommandResult{
+			Action:  "git_remove_host",
+			Success: true,
+			Message: fmt.Sprintf("✓ Host removed: %s", config.NormalizeGitHost(host)),
+		}
+
+	case "git_set_provider":
+		repoURL, provider := commands.GetRepoProviderValue(cmd.Name)
+		if repoURL == "" || provider == "" {
+			return &CommandResult{
+				Action:  "git_set_provider",
+				Success: false,
+				Message: "Invalid git provider command",
+			}
+		}
+
+		err := config.SetGitRepoProvider(repoURL, provider)
+		if err != nil {
+			return &CommandResult{
+				Action:  "git_set_provider",
+				Success: false,
+				Message: fmt.Sprintf("Failed to set provider: %v", err),
+			}
+		}
+
+		return &CommandResult{
+			Action:  "git_set_provider",
+			Success: true,
+			Message: fmt.Sprintf("✓ Provider for %s set to: %s", repoURL, provider),
+		}
 	}
 
 	return nil
@@ -7,6 +7,20 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 )
 
+var commitsFilterActions = map[string]bool{
+	"filter_by_author":     true,
+	"clear_author_filter":  true,
+	"filter_by_branch":     true,
+	"clear_branch_filter":  true,
+	"filter_by_merges":     true,
+	"filter_by_path":       true,
+	"clear_path_filter":    true,
+	"filter_by_message":    true,
+	"clear_message_filter": true,
+	"filter_by_type":       true,
+	"clear_type_filter":    true,
+}
+
 type StatisticsCommandHandler struct {
 	CommandHandler
 	selectedFolder string
@@ -64,6 +78,21 @@ func (h StatisticsCommandHandler) processCommand() (StatisticsCommandHandler, te
 		}
 	}
 
+	if validatedCmd.Action == "void_set_range" {
+		fromDate, toDate, err := commands.GetDateRangeFromCommand(validatedCmd.Name)
+		if err != nil {
+			h.commandError = fmt.Sprintf("Error parsing date range: %v", err)
+			return h, nil, nil
+		}
+
+		return h, nil, &CommandResult{
+			Action:  "void_set_range",
+			Success: true,
+			Message: fmt.Sprintf("✓ Analysis range set to: %s – %s", fromDate.Format("January 2, 2006"), toDate.Format("January 2, 2006")),
+			Data:    map[string]interface{}{"date": fromDate, "until": toDate},
+		}
+	}
+
 	navigationCommands := map[string]string{
 		"start": "statistics",
 		"reset": "welcome",
@@ -104,7 +133,7 @@ func (h StatisticsCommandHandler) processCommand() (StatisticsCommandHandler, te
 		return h, nil, nil
 	}
 
-	if validatedCmd.Action == "filter_by_author" || validatedCmd.Action == "clear_author_filter" || validatedCmd.Action == "filter_by_branch" || validatedCmd.Action == "clear_branch_filter" {
+	if commitsFilterActions[validatedCmd.Action] {
 		return h, nil, &CommandResult{
 			Action:  validatedCmd.Action,
 			Success: true,
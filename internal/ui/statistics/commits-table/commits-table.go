@@ -4,11 +4,13 @@ import (
 	"math/rand"
 	"time"
 
+	"project-void/internal/git"
 	"project-void/internal/ui/styles"
 
 	"github.com/charmbracelet/bubbles/progress"
 	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -43,6 +45,16 @@ type Model struct {
 	progress      progress.Model
 	loadError     string
 	spinner       *spinner.Model
+
+	showStats         bool
+	showDetail        bool
+	detail            viewport.Model
+	detailHash        string
+	files             map[string]*commitFiles
+	commits           []git.Commit
+	failures          []git.SourceError
+	truncated         *git.TruncatedError
+	truncationWarning string
 }
 
 type LoadCommitsProgressMsg struct {
@@ -53,7 +65,7 @@ type tickMsg time.Time
 
 type LoadingCompleteMsg struct{}
 
-func getCommitTableColumns(width int) []table.Column {
+func getCommitTableColumns(width int, showStats bool) []table.Column {
 	if width < 60 {
 		width = 60
 	}
@@ -62,11 +74,18 @@ func getCommitTableColumns(width int) []table.Column {
 	authorWidth := 20
 	dateWidth := 12
 	repoWidth := 15
-	numColumns := 5
+	typeWidth := 8
+	scopeWidth := 10
+	statsWidth := 0
+	numColumns := 7
+	if showStats {
+		statsWidth = 16
+		numColumns = 8
+	}
 
 	borderPadding := 10 + (numColumns-1)*2
 
-	messageWidth := width - branchWidth - authorWidth - dateWidth - repoWidth - borderPadding
+	messageWidth := width - branchWidth - authorWidth - dateWidth - repoWidth - typeWidth - scopeWidth - statsWidth - borderPadding
 
 	if messageWidth < 20 {
 		messageWidth = 20
@@ -75,24 +94,36 @@ func getCommitTableColumns(width int) []table.Column {
 			authorWidth = 15
 			dateWidth = 10
 			repoWidth = 12
-			messageWidth = width - branchWidth - authorWidth - dateWidth - repoWidth - borderPadding
+			typeWidth = 6
+			scopeWidth = 8
+			if showStats {
+				statsWidth = 13
+			}
+			messageWidth = width - branchWidth - authorWidth - dateWidth - repoWidth - typeWidth - scopeWidth - statsWidth - borderPadding
 			if messageWidth < 15 {
 				messageWidth = 15
 			}
 		}
 	}
 
-	return []table.Column{
+	columns := []table.Column{
 		{Title: "Repo", Width: repoWidth},
 		{Title: "Branch", Width: branchWidth},
 		{Title: "Author", Width: authorWidth},
 		{Title: "Date", Width: dateWidth},
-		{Title: "Message", Width: messageWidth},
+		{Title: "Type", Width: typeWidth},
+		{Title: "Scope", Width: scopeWidth},
+	}
+	if showStats {
+		columns = append(columns, table.Column{Title: "+/- (files)", Width: statsWidth})
 	}
+	columns = append(columns, table.Column{Title: "Message", Width: messageWidth})
+
+	return columns
 }
 
 func InitialModel() Model {
-	columns := getCommitTableColumns(94)
+	columns := getCommitTableColumns(94, false)
 	t := table.New(
 		table.WithColumns(columns),
 		table.WithRows([]table.Row{}),
@@ -120,6 +151,7 @@ func InitialModel() Model {
 		borderFocused: true,
 		loadingState:  LoadingIdle,
 		progress:      p,
+		detail:        viewport.New(0, 0),
 	}
 }
 
@@ -144,6 +176,27 @@ func (m Model) TotalCommits() int {
 	return len(m.table.Rows())
 }
 
+func (m Model) TruncationWarning() string {
+	return m.truncationWarning
+}
+
+func (m Model) Failures() []git.SourceError {
+	return m.failures
+}
+
+func (m *Model) SetShowStats(show bool) {
+	m.showStats = show
+
+	width := m.width
+	if width == 0 {
+		width = 94
+	}
+
+	m.table.SetRows([]table.Row{})
+	m.table.SetColumns(getCommitTableColumns(width, show))
+	m.setCommitRows()
+}
+
 func (m *Model) Focus() {
 	m.table.Focus()
 }
@@ -189,6 +242,11 @@ func (m *Model) StartLoadingWithCmd() tea.Cmd {
 	return tickCmd()
 }
 
+func (m *Model) SetLoadError(err string) {
+	m.loadingState = LoadingError
+	m.loadError = err
+}
+
 func (m *Model) UpdateProgress(percent float64) tea.Cmd {
 	if m.loadingState == LoadingInProgress {
 		return m.progress.SetPercent(percent)
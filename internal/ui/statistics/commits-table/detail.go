@@ -0,0 +1,236 @@
+package commitstable
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"project-void/internal/git"
+	"project-void/internal/ui/styles"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+const detailMinHeight = 6
+
+type commitFiles struct {
+	loading     bool
+	files       []git.FileChange
+	err         string
+	unsupported bool
+}
+
+func (m Model) SelectedCommit() (git.Commit, bool) {
+	cursor := m.table.Cursor()
+	if cursor < 0 || cursor >= len(m.commits) {
+		return git.Commit{}, false
+	}
+	return m.commits[cursor], true
+}
+
+func (m *Model) ToggleDetail() {
+	m.showDetail = !m.showDetail
+	m.syncDetail()
+}
+
+func (m *Model) HideDetail() {
+	m.showDetail = false
+}
+
+func (m Model) IsShowingDetail() bool {
+	return m.showDetail
+}
+
+func (m *Model) NeedsFiles(hash string) bool {
+	_, ok := m.files[hash]
+	return !ok
+}
+
+func (m *Model) SetFilesLoading(hash string) {
+	if m.files == nil {
+		m.files = make(map[string]*commitFiles)
+	}
+	m.files[hash] = &commitFiles{loading: true}
+}
+
+func (m *Model) SetFiles(hash string, files []git.FileChange, err error) {
+	if m.files == nil {
+		m.files = make(map[string]*commitFiles)
+	}
+	entry := &commitFiles{files: files}
+	if err != nil {
+		entry.err = err.Error()
+		entry.unsupported = errors.Is(err, git.ErrCommitFilesUnsupported)
+	}
+	m.files[hash] = entry
+
+	if err == nil {
+		m.applyFileStats(hash, files)
+	}
+	m.syncDetail()
+}
+
+// syncDetail refreshes the detail viewport for the selected commit, going
+// back to the top when the selection changes.
+func (m *Model) syncDetail() {
+	commit, ok := m.SelectedCommit()
+	if !m.showDetail || !ok {
+		return
+	}
+
+	if commit.Hash != m.detailHash {
+		m.detailHash = commit.Hash
+		m.detail.GotoTop()
+	}
+
+	width := 0
+	if m.width > 0 {
+		width = m.width - 4
+	}
+
+	content := m.detailContent(commit)
+	if width > 0 {
+		content = lipgloss.NewStyle().Width(width).Render(content)
+	}
+
+	m.detail.Width = max(width, lipgloss.Width(content))
+	m.detail.Height = min(lipgloss.Height(content), max(m.table.Height(), detailMinHeight))
+	m.detail.SetContent(content)
+}
+
+func (m *Model) scrollDetail(key string) bool {
+	switch key {
+	case "J":
+		m.detail.ScrollDown(1)
+	case "K":
+		m.detail.ScrollUp(1)
+	case "ctrl+d":
+		m.detail.HalfPageDown()
+	case "ctrl+u":
+		m.detail.HalfPageUp()
+	default:
+		return false
+	}
+	return true
+}
+
+func (m Model) detailView() string {
+	if _, ok := m.SelectedCommit(); !ok {
+		return ""
+	}
+
+	m.syncDetail()
+
+	labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
+	help := "enter/esc: close"
+	if !m.detail.AtTop() || !m.detail.AtBottom() {
+		help = fmt.Sprintf("enter/esc: close • J/K, ctrl+d/u: scroll (%d%%)", int(m.detail.ScrollPercent()*100))
+	}
+
+	style := lipgloss.NewStyle().
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(styles.HighlightColor).
+		Padding(0, 1)
+	if m.width > 0 {
+		style = style.Width(m.width - 2)
+	}
+
+	return style.Render(m.detail.View() + "\n" + labelStyle.Render(help))
+}
+
+func (m Model) detailContent(commit git.Commit) string {
+	labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
+
+	message := strings.TrimRight(commit.Message, "\n")
+	subject, body, _ := strings.Cut(message, "\n")
+
+	var lines []string
+	lines = append(lines, lipgloss.NewStyle().Bold(true).Render(strings.TrimSpace(subject)))
+	lines = append(lines, fmt.Sprintf("%s %s", labelStyle.Render("Commit:   "), commit.Hash))
+	lines = append(lines, fmt.Sprintf("%s %s", labelStyle.Render("Repo:     "), commit.RepoName))
+	lines = append(lines, fmt.Sprintf("%s %s", labelStyle.Render("Author:   "), formatIdentity(commit.Author, commit.AuthorEmail, commit.Timestamp.Format("2006-01-02 15:04:05 -0700"))))
+	if commit.Committer != "" {
+		lines = append(lines, fmt.Sprintf("%s %s", labelStyle.Render("Committer:"), formatIdentity(commit.Committer, commit.CommitterEmail, commit.CommitTime.Format("2006-01-02 15:04:05 -0700"))))
+	}
+	for _, coAuthor := range commit.CoAuthors {
+		lines = append(lines, fmt.Sprintf("%s %s", labelStyle.Render("Co-author:"), formatIdentity(coAuthor.Name, coAuthor.Email, "")))
+	}
+
+	if len(commit.Parents) > 0 {
+		var parents []string
+		for _, parent := range commit.Parents {
+			if len(parent) > 12 {
+				parent = parent[:12]
+			}
+			parents = append(parents, parent)
+		}
+		lines = append(lines, fmt.Sprintf("%s %s", labelStyle.Render("Parents:  "), strings.Join(parents, ", ")))
+	}
+
+	if len(commit.Branches) > 0 {
+		var branches []string
+		for _, branch := range commit.Branches {
+			if branch == commit.Branch {
+				branch = lipgloss.NewStyle().Foreground(styles.HighlightColor).Render(branch + " (primary)")
+			}
+			branches = append(branches, branch)
+		}
+		lines = append(lines, fmt.Sprintf("%s %s", labelStyle.Render("Branches: "), strings.Join(branches, ", ")))
+	} else if commit.Branch != "" {
+		lines = append(lines, fmt.Sprintf("%s %s", labelStyle.Render("Branch:   "), commit.Branch))
+	}
+
+	body = strings.Trim(body, "\n")
+	if body != "" {
+		lines = append(lines, "")
+		lines = append(lines, strings.Split(body, "\n")...)
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, m.filesView(commit.Hash, labelStyle)...)
+
+	return strings.Join(lines, "\n")
+}
+
+func (m Model) filesView(hash string, labelStyle lipgloss.Style) []string {
+	entry, ok := m.files[hash]
+	if !ok || entry.loading {
+		return []string{labelStyle.Render("Loading changed files...")}
+	}
+	if entry.err != "" {
+		return []string{lipgloss.NewStyle().Foreground(lipgloss.Color("196")).Render("Changed files unavailable: " + entry.err)}
+	}
+	if len(entry.files) == 0 {
+		return []string{labelStyle.Render("No changed files")}
+	}
+
+	addStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("34"))
+	delStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
+
+	var additions, deletions int
+	for _, file := range entry.files {
+		additions += file.Additions
+		deletions += file.Deletions
+	}
+
+	lines := []string{labelStyle.Render(fmt.Sprintf("Changed files (%d, +%d/-%d):", len(entry.files), additions, deletions))}
+	for _, file := range entry.files {
+		lines = append(lines, fmt.Sprintf("  %s %s %s",
+			addStyle.Render(fmt.Sprintf("%6s", fmt.Sprintf("+%d", file.Additions))),
+			delStyle.Render(fmt.Sprintf("%6s", fmt.Sprintf("-%d", file.Deletions))),
+			file.Path,
+		))
+	}
+	return lines
+}
+
+func formatIdentity(name, email, when string) string {
+	identity := name
+	if email != "" {
+		identity += fmt.Sprintf(" <%s>", email)
+	}
+	if when != "" {
+		identity += "  " + when
+	}
+	return identity
+}
@@ -1,122 +1,87 @@
 package commitstable
 
 import (
+	"context"
 	"fmt"
 	"project-void/internal/git"
+	"slices"
+	"sort"
 	"strings"
-	"time"
 
 	"github.com/charmbracelet/bubbles/table"
 )
 
-func (m *Model) LoadCommits(repoPath string, since time.Time) error {
+func (m *Model) LoadCommits(ctx context.Context, repoPaths []string, query git.CommitQuery) error {
 	m.loadingState = LoadingInProgress
 	m.progress.SetPercent(0.0)
 
-	commits, err := git.GetCommitsSince(repoPath, since)
+	result, err := git.GetCommits(ctx, repoPaths, query)
 	if err != nil {
 		m.loadingState = LoadingError
 		m.loadError = err.Error()
 		return fmt.Errorf("failed to load commits: %w", err)
 	}
 
-	rows := make([]table.Row, len(commits))
-	for i, commit := range commits {
-		repoDisplay := commit.RepoName
-		if len(repoDisplay) > 13 {
-			repoDisplay = repoDisplay[:10] + "..."
-		}
-
-		shortBranch := commit.Branch
-		if len(shortBranch) > 10 {
-			shortBranch = shortBranch[:10]
-		}
-
-		dateStr := commit.Timestamp.Format("2006-01-02")
-
-		message := strings.ReplaceAll(commit.Message, "\n", " ")
-		message = strings.TrimSpace(message)
-		if len(message) > 80 {
-			message = message[:77] + "..."
-		}
-
-		rows[i] = table.Row{
-			repoDisplay,
-			shortBranch,
-			commit.Author,
-			dateStr,
-			message,
-		}
-	}
-
-	m.table.SetRows(rows)
+	m.commits = result.Commits
+	m.files = make(map[string]*commitFiles)
+	m.failures = result.Failures
+	m.truncated = result.Truncated
+	m.setCommitRows()
 	return nil
 }
 
-func (m *Model) LoadCommitsByAuthors(repoPath string, since time.Time, authorNames []string) error {
-	m.loadingState = LoadingInProgress
-	m.progress.SetPercent(0.0)
-
-	commits, err := git.GetCommitsSinceByAuthors(repoPath, since, authorNames)
-	if err != nil {
-		m.loadingState = LoadingError
-		m.loadError = err.Error()
-		return fmt.Errorf("failed to load commits by authors: %w", err)
+func (m *Model) MergeRetry(source string, result *git.CommitResult) {
+	var failures []git.SourceError
+	for _, failure := range m.failures {
+		if failure.Source != source {
+			failures = append(failures, failure)
+		}
 	}
+	m.failures = append(failures, result.Failures...)
 
-	rows := make([]table.Row, len(commits))
-	for i, commit := range commits {
-		repoDisplay := commit.RepoName
-		if len(repoDisplay) > 13 {
-			repoDisplay = repoDisplay[:10] + "..."
+	var commits []git.Commit
+	for _, commit := range m.commits {
+		if commit.Source != source {
+			commits = append(commits, commit)
 		}
+	}
+	m.commits = append(commits, result.Commits...)
+	sort.SliceStable(m.commits, func(i, j int) bool {
+		return m.commits[i].Timestamp.After(m.commits[j].Timestamp)
+	})
 
-		shortBranch := commit.Branch
-		if len(shortBranch) > 10 {
-			shortBranch = shortBranch[:10]
+	if result.Truncated != nil {
+		if m.truncated == nil {
+			m.truncated = &git.TruncatedError{}
 		}
-
-		dateStr := commit.Timestamp.Format("2006-01-02")
-
-		message := strings.ReplaceAll(commit.Message, "\n", " ")
-		message = strings.TrimSpace(message)
-		if len(message) > 80 {
-			message = message[:77] + "..."
+		for _, truncatedSource := range result.Truncated.Sources {
+			if !slices.Contains(m.truncated.Sources, truncatedSource) {
+				m.truncated.Sources = append(m.truncated.Sources, truncatedSource)
+			}
 		}
-
-		rows[i] = table.Row{
-			repoDisplay,
-			shortBranch,
-			commit.Author,
-			dateStr,
-			message,
+		if result.Truncated.MaxPages > m.truncated.MaxPages {
+			m.truncated.MaxPages = result.Truncated.MaxPages
 		}
 	}
 
-	m.table.SetRows(rows)
-	return nil
+	m.setCommitRows()
 }
 
-func (m *Model) LoadCommitsByBranches(repoPath string, since time.Time, branchNames []string) error {
-	m.loadingState = LoadingInProgress
-	m.progress.SetPercent(0.0)
-
-	commits, err := git.GetCommitsSinceByBranches(repoPath, since, branchNames)
-	if err != nil {
-		m.loadingState = LoadingError
-		m.loadError = err.Error()
-		return fmt.Errorf("failed to load commits by branches: %w", err)
-	}
-
-	rows := make([]table.Row, len(commits))
-	for i, commit := range commits {
+func (m *Model) setCommitRows() {
+	rows := make([]table.Row, len(m.commits))
+	for i, commit := range m.commits {
 		repoDisplay := commit.RepoName
 		if len(repoDisplay) > 13 {
 			repoDisplay = repoDisplay[:10] + "..."
 		}
 
 		shortBranch := commit.Branch
-		if len(shortBranch) > 10 {
+		if len(commit.Branches) > 1 {
+			if len(shortBranch) > 7 {
+				shortBranch = shortBranch[:7]
+			}
+			shortBranch = fmt.Sprintf("%s +%d", shortBranch, len(commit.Branches)-1)
+		} else if len(shortBranch) > 10 {
 			shortBranch = shortBranch[:10]
 		}
 
@@ -128,235 +93,214 @@ func (m *Model) LoadCommitsByBranches(repoPath string, since time.Time, branchNa
 			message = message[:77] + "..."
 		}
 
-		rows[i] = table.Row{
-			repoDisplay,
-			shortBranch,
-			commit.Author,
-			dateStr,
-			message,
-		}
-	}
-
-	m.table.SetRows(rows)
-	return nil
-}
-
-func (m *Model) LoadCommitsByAuthorsAndBranches(repoPath string, since time.Time, authorNames []string, branchNames []string) error {
-	m.loadingState = LoadingInProgress
-	m.progress.SetPercent(0.0)
-
-	commits, err := git.GetCommitsSinceByAuthorsAndBranches(repoPath, since, authorNames, branchNames)
-	if err != nil {
-		m.loadingState = LoadingError
-		m.loadError = err.Error()
-		return fmt.Errorf("failed to load commits by authors and branches: %w", err)
-	}
-
-	rows := make([]table.Row, len(commits))
-	for i, commit := range commits {
-		repoDisplay := commit.RepoName
-		if len(repoDisplay) > 13 {
-			repoDisplay = repoDisplay[:10] + "..."
+		authorDisplay := commit.Author
+		if len(commit.CoAuthors) > 0 {
+			authorDisplay = fmt.Sprintf("%s +%d", commit.Author, len(commit.CoAuthors))
 		}
 
-		shortBranch := commit.Branch
-		if len(shortBranch) > 10 {
-			shortBranch = shortBranch[:10]
+		typeDisplay := commit.Type
+		if commit.Breaking {
+			typeDisplay += "!"
 		}
 
-		dateStr := commit.Timestamp.Format("2006-01-02")
-
-		message := strings.ReplaceAll(commit.Message, "\n", " ")
-		message = strings.TrimSpace(message)
-		if len(message) > 80 {
-			message = message[:77] + "..."
+		scopeDisplay := commit.Scope
+		if len(scopeDisplay) > 10 {
+			scopeDisplay = scopeDisplay[:7] + "..."
 		}
 
-		rows[i] = table.Row{
+		row := table.Row{
 			repoDisplay,
 			shortBranch,
-			commit.Author,
+			authorDisplay,
 			dateStr,
-			message,
+			typeDisplay,
+			scopeDisplay,
+		}
+		if m.showStats {
+			statsStr := "-"
+			if commit.HasStats {
+				statsStr = fmt.Sprintf("+%d/-%d (%d)", commit.Additions, commit.Deletions, commit.FilesChanged)
+			}
+			row = append(row, statsStr)
 		}
+		rows[i] = append(row, message)
 	}
 
 	m.table.SetRows(rows)
-	return nil
-}
-
-func (m *Model) LoadCommitsFromMultipleRepos(repoPaths []string, since time.Time) error {
-	m.loadingState = LoadingInProgress
-	m.progress.SetPercent(0.0)
 
-	commits, err := git.GetCommitsSinceFromMultipleRepos(repoPaths, since)
-	if err != nil {
-		m.loadingState = LoadingError
-		m.loadError = err.Error()
-		return fmt.Errorf("failed to load commits from multiple repos: %w", err)
+	m.truncationWarning = ""
+	if m.truncated != nil {
+		m.truncationWarning = m.truncated.Error()
 	}
+}
 
-	rows := make([]table.Row, len(commits))
-	for i, commit := range commits {
-		repoDisplay := commit.RepoName
-		if len(repoDisplay) > 13 {
-			repoDisplay = repoDisplay[:10] + "..."
+func (m Model) TypeSummary() string {
+	counts := make(map[string]int)
+	var types []string
+	breaking := 0
+	for _, commit := range m.commits {
+		if commit.Breaking {
+			breaking++
 		}
-
-		shortBranch := commit.Branch
-		if len(shortBranch) > 10 {
-			shortBranch = shortBranch[:10]
+		if commit.Type == "" {
+			continue
 		}
-
-		dateStr := commit.Timestamp.Format("2006-01-02")
-
-		message := strings.ReplaceAll(commit.Message, "\n", " ")
-		message = strings.TrimSpace(message)
-		if len(message) > 80 {
-			message = message[:77] + "..."
-		}
-
-		rows[i] = table.Row{
-			repoDisplay,
-			shortBranch,
-			commit.Author,
-			dateStr,
-			message,
+		if counts[commit.Type] == 0 {
+			types = append(types, commit.Type)
 		}
+		counts[commit.Type]++
 	}
 
-	m.table.SetRows(rows)
-	return nil
-}
-
-func (m *Model) LoadCommitsByAuthorsFromMultipleRepos(repoPaths []string, since time.Time, authorNames []string) error {
-	m.loadingState = LoadingInProgress
-	m.progress.SetPercent(0.0)
-
-	commits, err := git.GetCommitsSinceByAuthorsFromMultipleRepos(repoPaths, since, authorNames)
-	if err != nil {
-		m.loadingState = LoadingError
-		m.loadError = err.Error()
-		return fmt.Errorf("failed to load commits by authors from multiple repos: %w", err)
+	if len(types) == 0 {
+		return ""
 	}
 
-	rows := make([]table.Row, len(commits))
-	for i, commit := range commits {
-		repoDisplay := commit.RepoName
-		if len(repoDisplay) > 13 {
-			repoDisplay = repoDisplay[:10] + "..."
-		}
-
-		shortBranch := commit.Branch
-		if len(shortBranch) > 10 {
-			shortBranch = shortBranch[:10]
-		}
+	sort.SliceStable(types, func(i, j int) bool {
+		return counts[types[i]] > counts[types[j]]
+	})
 
-		dateStr := commit.Timestamp.Format("2006-01-02")
-
-		message := strings.ReplaceAll(commit.Message, "\n", " ")
-		message = strings.TrimSpace(message)
-		if len(message) > 80 {
-			message = message[:77] + "..."
-		}
-
-		rows[i] = table.Row{
-			repoDisplay,
-			shortBranch,
-			commit.Author,
-			dateStr,
-			message,
-		}
+	var parts []string
+	for _, commitType := range types {
+		parts = append(parts, fmt.Sprintf("%d %s", counts[commitType], commitType))
 	}
-
-	m.table.SetRows(rows)
-	return nil
+	if breaking > 0 {
+		parts = append(parts, fmt.Sprintf("%d breaking", breaking))
+	}
+	return strings.Join(parts, " · ")
 }
 
-func (m *Model) LoadCommitsByBranchesFromMultipleRepos(repoPaths []string, since time.Time, branchNames []string) error {
-	m.loadingState = LoadingInProgress
-	m.progress.SetPercent(0.0)
-
-	commits, err := git.GetCommitsSinceByBranchesFromMultipleRepos(repoPaths, since, branchNames)
-	if err != nil {
-		m.loadingState = LoadingError
-		m.loadError = err.Error()
-		return fmt.Errorf("failed to load commits by branches from multiple repos: %w", err)
+// StatsToLoad returns the commits around the cursor that are still missing
+// diff stats and marks their changed files as loading.
+func (m *Model) StatsToLoad() []git.Commit {
+	if !m.showStats {
+		return nil
 	}
 
-	rows := make([]table.Row, len(commits))
-	for i, commit := range commits {
-		repoDisplay := commit.RepoName
-		if len(repoDisplay) > 13 {
-			repoDisplay = repoDisplay[:10] + "..."
-		}
+	cursor, height := m.table.Cursor(), m.table.Height()
+	from := max(cursor-height, 0)
+	to := min(cursor+height+1, len(m.commits))
 
-		shortBranch := commit.Branch
-		if len(shortBranch) > 10 {
-			shortBranch = shortBranch[:10]
+	var commits []git.Commit
+	for _, commit := range m.commits[from:to] {
+		if commit.HasStats || !m.NeedsFiles(commit.Hash) {
+			continue
 		}
+		m.SetFilesLoading(commit.Hash)
+		commits = append(commits, commit)
+	}
+	return commits
+}
 
-		dateStr := commit.Timestamp.Format("2006-01-02")
-
-		message := strings.ReplaceAll(commit.Message, "\n", " ")
-		message = strings.TrimSpace(message)
-		if len(message) > 80 {
-			message = message[:77] + "..."
+func (m *Model) applyFileStats(hash string, files []git.FileChange) {
+	updated := false
+	for i := range m.commits {
+		commit := &m.commits[i]
+		if commit.Hash != hash || commit.HasStats {
+			continue
 		}
 
-		rows[i] = table.Row{
-			repoDisplay,
-			shortBranch,
-			commit.Author,
-			dateStr,
-			message,
+		commit.Additions, commit.Deletions = 0, 0
+		for _, file := range files {
+			commit.Additions += file.Additions
+			commit.Deletions += file.Deletions
 		}
+		commit.FilesChanged = len(files)
+		commit.HasStats = true
+		updated = true
 	}
 
-	m.table.SetRows(rows)
-	return nil
+	if updated && m.showStats {
+		m.setCommitRows()
+	}
 }
 
-func (m *Model) LoadCommitsByAuthorsAndBranchesFromMultipleRepos(repoPaths []string, since time.Time, authorNames []string, branchNames []string) error {
-	m.loadingState = LoadingInProgress
-	m.progress.SetPercent(0.0)
+type changeTotal struct {
+	name      string
+	additions int
+	deletions int
+}
 
-	commits, err := git.GetCommitsSinceByAuthorsAndBranchesFromMultipleRepos(repoPaths, since, authorNames, branchNames)
-	if err != nil {
-		m.loadingState = LoadingError
-		m.loadError = err.Error()
-		return fmt.Errorf("failed to load commits by authors and branches from multiple repos: %w", err)
+func (m Model) StatsSummary() string {
+	if !m.showStats {
+		return ""
 	}
 
-	rows := make([]table.Row, len(commits))
-	for i, commit := range commits {
-		repoDisplay := commit.RepoName
-		if len(repoDisplay) > 13 {
-			repoDisplay = repoDisplay[:10] + "..."
-		}
+	var additions, deletions, files int
+	authorTotals := make(map[string]*changeTotal)
+	repoTotals := make(map[string]*changeTotal)
+	var authors, repos []*changeTotal
+	hasStats := false
+	statsFailed := 0
+
+	for _, commit := range m.commits {
+		if !commit.HasStats {
+			if entry, ok := m.files[commit.Hash]; ok && entry.err != "" && !entry.unsupported {
+				statsFailed++
+			}
+			continue
+		}
+		hasStats = true
+		additions += commit.Additions
+		deletions += commit.Deletions
+		files += commit.FilesChanged
+
+		names := []string{commit.Author}
+		for _, coAuthor := range commit.CoAuthors {
+			names = append(names, coAuthor.Name)
+		}
+		for _, name := range names {
+			author, ok := authorTotals[name]
+			if !ok {
+				author = &changeTotal{name: name}
+				authorTotals[name] = author
+				authors = append(authors, author)
+			}
+			author.additions += commit.Additions
+			author.deletions += commit.Deletions
+		}
+
+		repo, ok := repoTotals[commit.RepoName]
+		if !ok {
+			repo = &changeTotal{name: commit.RepoName}
+			repoTotals[commit.RepoName] = repo
+			repos = append(repos, repo)
+		}
+		repo.additions += commit.Additions
+		repo.deletions += commit.Deletions
+	}
 
-		shortBranch := commit.Branch
-		if len(shortBranch) > 10 {
-			shortBranch = shortBranch[:10]
-		}
+	var failedSummary string
+	if statsFailed > 0 {
+		failedSummary = fmt.Sprintf("stats failed for %d commits", statsFailed)
+	}
 
-		dateStr := commit.Timestamp.Format("2006-01-02")
+	if !hasStats {
+		return failedSummary
+	}
 
-		message := strings.ReplaceAll(commit.Message, "\n", " ")
-		message = strings.TrimSpace(message)
-		if len(message) > 80 {
-			message = message[:77] + "..."
-		}
+	summary := fmt.Sprintf("+%d/-%d in %d files", additions, deletions, files)
+	summary += " · authors: " + formatChangeTotals(authors)
+	if len(repos) > 1 {
+		summary += " · repos: " + formatChangeTotals(repos)
+	}
+	if failedSummary != "" {
+		summary += " · " + failedSummary
+	}
+	return summary
+}
 
-		rows[i] = table.Row{
-			repoDisplay,
-			shortBranch,
-			commit.Author,
-			dateStr,
-			message,
+func formatChangeTotals(totals []*changeTotal) string {
+	sort.SliceStable(totals, func(i, j int) bool {
+		return totals[i].additions+totals[i].deletions > totals[j].additions+totals[j].deletions
+	})
+
+	var parts []string
+	for i, total := range totals {
+		if i == 3 {
+			parts = append(parts, fmt.Sprintf("+%d more", len(totals)-3))
+			break
 		}
+		parts = append(parts, fmt.Sprintf("%s +%d/-%d", total.name, total.additions, total.deletions))
 	}
-
-	m.table.SetRows(rows)
-	return nil
+	return strings.Join(parts, ", ")
 }
@@ -4,12 +4,21 @@ import (
 	"math/rand"
 
 	"github.com/charmbracelet/bubbles/progress"
+	"github.com/charmbracelet/bubbles/table"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
 	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if m.showDetail && m.table.Focused() {
+			m.syncDetail()
+			if m.scrollDetail(msg.String()) {
+				return m, nil
+			}
+		}
+
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
@@ -26,8 +35,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.table.SetHeight(tableHeight)
 
 		if m.width > 0 {
-			columns := getCommitTableColumns(m.width)
+			columns := getCommitTableColumns(m.width, m.showStats)
+			m.table.SetRows([]table.Row{})
 			m.table.SetColumns(columns)
+			m.setCommitRows()
 		}
 
 	case LoadCommitsProgressMsg:
@@ -70,5 +81,6 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	}
 
 	m.table, cmd = m.table.Update(msg)
+	m.syncDetail()
 	return m, cmd
 }
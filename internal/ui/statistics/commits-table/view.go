@@ -62,5 +62,11 @@ func (m Model) View() string {
 		tableView = style.Render(m.table.View())
 	}
 
+	if m.showDetail {
+		if detail := m.detailView(); detail != "" {
+			return lipgloss.JoinVertical(lipgloss.Left, tableView, detail)
+		}
+	}
+
 	return tableView
 }
@@ -187,6 +187,11 @@ func (m *Model) StartLoadingWithCmd() tea.Cmd {
 	return tickCmd()
 }
 
+func (m *Model) SetLoadError(err string) {
+	m.loadingState = LoadingError
+	m.loadError = err
+}
+
 func (m *Model) UpdateProgress(percent float64) tea.Cmd {
 	if m.loadingState == LoadingInProgress {
 		return m.progress.SetPercent(percent)
@@ -1,6 +1,7 @@
 package jiratable
 
 import (
+	"context"
 	"fmt"
 	"project-void/internal/jira"
 	"strings"
@@ -9,11 +10,11 @@ import (
 	"github.com/charmbracelet/bubbles/table"
 )
 
-func (m *Model) LoadIssues(jiraClient *jira.JiraClient, since time.Time, config *jira.JiraConfig) error {
+func (m *Model) LoadIssues(ctx context.Context, jiraClient *jira.JiraClient, since, until time.Time, config *jira.JiraConfig) error {
 	m.loadingState = LoadingInProgress
 	m.progress.SetPercent(0.0)
 
-	issues, err := jiraClient.GetIssuesSince(since, config)
+	issues, err := jiraClient.GetIssuesSince(ctx, since, until, config)
 	if err != nil {
 		m.loadingState = LoadingError
 		m.loadError = err.Error()
@@ -1,7 +1,9 @@
 package statistics
 
 import (
+	"context"
 	"fmt"
+	"project-void/internal/git"
 	"project-void/internal/jira"
 	commitstable "project-void/internal/ui/statistics/commits-table"
 	jiratable "project-void/internal/ui/statistics/jira-table"
@@ -10,164 +12,56 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 )
 
-func loadCommitsCmd(repoSource string, since time.Time) tea.Cmd {
-	return tea.Cmd(func() tea.Msg {
-		if repoSource == "" {
-			emptyTable := commitstable.InitialModel()
-			emptyTable.StartLoading()
-			return LoadedMsg{CommitsTable: emptyTable}
-		}
-
-		var commitsTable commitstable.Model = commitstable.InitialModel()
-		commitsTable.StartLoading()
-		err := commitsTable.LoadCommits(repoSource, since)
-		if err != nil {
-			return LoadErrorMsg{Error: err.Error()}
-		}
-
-		return LoadedMsg{CommitsTable: commitsTable}
-	})
-}
-
-func loadCommitsFromMultipleReposCmd(repoSources []string, since time.Time) tea.Cmd {
+func loadCommitsCmd(ctx context.Context, generation int, repoSources []string, query git.CommitQuery) tea.Cmd {
 	return tea.Cmd(func() tea.Msg {
 		if len(repoSources) == 0 {
 			emptyTable := commitstable.InitialModel()
+			emptyTable.SetShowStats(query.Stats)
 			emptyTable.StartLoading()
-			return LoadedMsg{CommitsTable: emptyTable}
-		}
-
-		var commitsTable commitstable.Model = commitstable.InitialModel()
-		commitsTable.StartLoading()
-		err := commitsTable.LoadCommitsFromMultipleRepos(repoSources, since)
-		if err != nil {
-			return LoadErrorMsg{Error: err.Error()}
-		}
-
-		return LoadedMsg{CommitsTable: commitsTable}
-	})
-}
-
-func loadCommitsByAuthorsCmd(repoSource string, since time.Time, authorNames []string) tea.Cmd {
-	return tea.Cmd(func() tea.Msg {
-		if repoSource == "" {
-			emptyTable := commitstable.InitialModel()
-			emptyTable.StartLoading()
-			return LoadedMsg{CommitsTable: emptyTable}
-		}
-
-		var commitsTable commitstable.Model = commitstable.InitialModel()
-		commitsTable.StartLoading()
-		err := commitsTable.LoadCommitsByAuthors(repoSource, since, authorNames)
-		if err != nil {
-			return LoadErrorMsg{Error: err.Error()}
-		}
-
-		return LoadedMsg{CommitsTable: commitsTable}
-	})
-}
-
-func loadCommitsByBranchesCmd(repoSource string, since time.Time, branchNames []string) tea.Cmd {
-	return tea.Cmd(func() tea.Msg {
-		if repoSource == "" {
-			emptyTable := commitstable.InitialModel()
-			emptyTable.StartLoading()
-			return LoadedMsg{CommitsTable: emptyTable}
-		}
-
-		var commitsTable commitstable.Model = commitstable.InitialModel()
-		commitsTable.StartLoading()
-		err := commitsTable.LoadCommitsByBranches(repoSource, since, branchNames)
-		if err != nil {
-			return LoadErrorMsg{Error: err.Error()}
-		}
-
-		return LoadedMsg{CommitsTable: commitsTable}
-	})
-}
-
-func loadCommitsByAuthorsAndBranchesCmd(repoSource string, since time.Time, authorNames []string, branchNames []string) tea.Cmd {
-	return tea.Cmd(func() tea.Msg {
-		if repoSource == "" {
-			emptyTable := commitstable.InitialModel()
-			emptyTable.StartLoading()
-			return LoadedMsg{CommitsTable: emptyTable}
+			return LoadedMsg{Generation: generation, CommitsTable: emptyTable}
 		}
 
 		var commitsTable commitstable.Model = commitstable.InitialModel()
+		commitsTable.SetShowStats(query.Stats)
 		commitsTable.StartLoading()
-		err := commitsTable.LoadCommitsByAuthorsAndBranches(repoSource, since, authorNames, branchNames)
+		err := commitsTable.LoadCommits(ctx, repoSources, query)
 		if err != nil {
-			return LoadErrorMsg{Error: err.Error()}
+			return LoadErrorMsg{Generation: generation, Error: err.Error()}
 		}
 
-		return LoadedMsg{CommitsTable: commitsTable}
+		return LoadedMsg{Generation: generation, CommitsTable: commitsTable}
 	})
 }
 
-func loadCommitsByAuthorsFromMultipleReposCmd(repoSources []string, since time.Time, authorNames []string) tea.Cmd {
+func loadCommitFilesCmd(ctx context.Context, generation int, source, hash string) tea.Cmd {
 	return tea.Cmd(func() tea.Msg {
-		if len(repoSources) == 0 {
-			emptyTable := commitstable.InitialModel()
-			emptyTable.StartLoading()
-			return LoadedMsg{CommitsTable: emptyTable}
-		}
-
-		var commitsTable commitstable.Model = commitstable.InitialModel()
-		commitsTable.StartLoading()
-		err := commitsTable.LoadCommitsByAuthorsFromMultipleRepos(repoSources, since, authorNames)
-		if err != nil {
-			return LoadErrorMsg{Error: err.Error()}
-		}
-
-		return LoadedMsg{CommitsTable: commitsTable}
+		files, err := git.GetCommitFiles(ctx, source, hash)
+		return CommitFilesLoadedMsg{Generation: generation, Hash: hash, Files: files, Err: err}
 	})
 }
 
-func loadCommitsByBranchesFromMultipleReposCmd(repoSources []string, since time.Time, branchNames []string) tea.Cmd {
+func loadCommitStatsCmd(ctx context.Context, generation int, commits []git.Commit) tea.Cmd {
 	return tea.Cmd(func() tea.Msg {
-		if len(repoSources) == 0 {
-			emptyTable := commitstable.InitialModel()
-			emptyTable.StartLoading()
-			return LoadedMsg{CommitsTable: emptyTable}
-		}
-
-		var commitsTable commitstable.Model = commitstable.InitialModel()
-		commitsTable.StartLoading()
-		err := commitsTable.LoadCommitsByBranchesFromMultipleRepos(repoSources, since, branchNames)
-		if err != nil {
-			return LoadErrorMsg{Error: err.Error()}
-		}
-
-		return LoadedMsg{CommitsTable: commitsTable}
+		return CommitStatsLoadedMsg{Generation: generation, Results: git.GetCommitFilesBatch(ctx, commits)}
 	})
 }
 
-func loadCommitsByAuthorsAndBranchesFromMultipleReposCmd(repoSources []string, since time.Time, authorNames []string, branchNames []string) tea.Cmd {
+func retryCommitsCmd(ctx context.Context, generation int, repoSource string, query git.CommitQuery) tea.Cmd {
 	return tea.Cmd(func() tea.Msg {
-		if len(repoSources) == 0 {
-			emptyTable := commitstable.InitialModel()
-			emptyTable.StartLoading()
-			return LoadedMsg{CommitsTable: emptyTable}
-		}
-
-		var commitsTable commitstable.Model = commitstable.InitialModel()
-		commitsTable.StartLoading()
-		err := commitsTable.LoadCommitsByAuthorsAndBranchesFromMultipleRepos(repoSources, since, authorNames, branchNames)
+		result, err := git.GetCommits(ctx, []string{repoSource}, query)
 		if err != nil {
-			return LoadErrorMsg{Error: err.Error()}
+			result = &git.CommitResult{Failures: []git.SourceError{{Source: repoSource, Err: err}}}
 		}
-
-		return LoadedMsg{CommitsTable: commitsTable}
+		return RetryLoadedMsg{Generation: generation, Source: repoSource, Result: result}
 	})
 }
 
-func loadJiraCmd(jiraSource string, since time.Time) tea.Cmd {
+func loadJiraCmd(ctx context.Context, generation int, jiraSource string, since, until time.Time) tea.Cmd {
 	return tea.Cmd(func() tea.Msg {
 		if jiraSource == "" {
 			emptyTable := jiratable.InitialModel()
 			emptyTable.StartLoading()
-			return JiraLoadedMsg{JiraTable: emptyTable}
+			return JiraLoadedMsg{Generation: generation, JiraTable: emptyTable}
 		}
 
 		var jiraTable jiratable.Model = jiratable.InitialModel()
@@ -175,15 +69,15 @@ func loadJiraCmd(jiraSource string, since time.Time) tea.Cmd {
 
 		config, err := jira.LoadConfig()
 		if err != nil {
-			return JiraLoadErrorMsg{Error: fmt.Sprintf("Failed to load JIRA config: %v", err)}
+			return JiraLoadErrorMsg{Generation: generation, Error: fmt.Sprintf("Failed to load JIRA config: %v", err)}
 		}
 
 		client := jira.NewClientFromConfig(config)
 
-		err = jiraTable.LoadIssues(client, since, config)
+		err = jiraTable.LoadIssues(ctx, client, since, until, config)
 		if err != nil {
-			return JiraLoadErrorMsg{Error: fmt.Sprintf("Failed to load JIRA issues: %v", err)}
+			return JiraLoadErrorMsg{Generation: generation, Error: fmt.Sprintf("Failed to load JIRA issues: %v", err)}
 		}
-		return JiraLoadedMsg{JiraTable: jiraTable}
+		return JiraLoadedMsg{Generation: generation, JiraTable: jiraTable}
 	})
 }
@@ -1,7 +1,9 @@
 package statistics
 
 import (
+	"context"
 	"project-void/internal/config"
+	"project-void/internal/git"
 	"project-void/internal/ui/common"
 	commitstable "project-void/internal/ui/statistics/commits-table"
 	jiratable "project-void/internal/ui/statistics/jira-table"
@@ -13,32 +15,49 @@ import (
 )
 
 type Model struct {
-	commitsTable        commitstable.Model
-	jiraTable           jiratable.Model
-	commandHandler      common.StatisticsCommandHandler
-	selectedFolder      string
-	selectedRepoSources []string
-	selectedJiraSource  string
-	selectedDate        time.Time
-	hasGit              bool
-	hasJira             bool
-	width               int
-	height              int
-	loaded              bool
-	loadError           string
-	focusedTable        int
-	command             string
-	submitted           bool
-	authorFilter        []string
-	branchFilter        []string
-	commitsSpinner      spinner.Model
-	jiraSpinner         spinner.Model
-	commitsLoading      bool
-	jiraLoading         bool
-	noConfigMessage     string
+	commitsTable         commitstable.Model
+	jiraTable            jiratable.Model
+	commandHandler       common.StatisticsCommandHandler
+	selectedFolder       string
+	selectedRepoSources  []string
+	selectedJiraSource   string
+	selectedDate         time.Time
+	selectedUntil        time.Time
+	hasGit               bool
+	hasJira              bool
+	width                int
+	height               int
+	loaded               bool
+	loadError            string
+	focusedTable         int
+	command              string
+	submitted            bool
+	authorFilter         []string
+	branchFilter         []string
+	mergeFilter          string
+	pathFilter           []string
+	messageFilter        string
+	messageCaseSensitive bool
+	typeFilter           []string
+	commitsSpinner       spinner.Model
+	jiraSpinner          spinner.Model
+	commitsLoading       bool
+	jiraLoading          bool
+	noConfigMessage      string
+	githubRateLimit      git.RateLimitStatus
+	jiraError            string
+	showStats            bool
+	matchCoAuthors       bool
+	retryingSources      map[string]bool
+	commitsCtx           context.Context
+	commitsCancel        context.CancelFunc
+	commitsGeneration    int
+	jiraCtx              context.Context
+	jiraCancel           context.CancelFunc
+	jiraGeneration       int
 }
 
-func InitialModel(selectedFolder string, selectedDate time.Time, hasGit, hasJira bool) Model {
+func InitialModel(selectedFolder string, selectedDate, selectedUntil time.Time, hasGit, hasJira bool) Model {
 	commitsTable := commitstable.InitialModel()
 	jiraTable := jiratable.InitialModel()
 
@@ -66,6 +85,14 @@ func InitialModel(selectedFolder string, selectedDate time.Time, hasGit, hasJira
 		repoSources = gitConfig.Git.RepoURLs
 	}
 
+	showStats := false
+	matchCoAuthors := false
+	if gitConfig, err := config.LoadUserConfig(); err == nil {
+		showStats = gitConfig.Git.ShowStats
+		matchCoAuthors = gitConfig.Git.MatchCoAuthors
+	}
+	commitsTable.SetShowStats(showStats)
+
 	jiraSource := ""
 	if jiraConfig, err := config.LoadUserConfig(); err == nil && jiraConfig.Jira.BaseURL != "" {
 		jiraSource = jiraConfig.Jira.BaseURL
@@ -98,6 +125,9 @@ func InitialModel(selectedFolder string, selectedDate time.Time, hasGit, hasJira
 	commitsTable.SetSpinner(&commitsSpinner)
 	jiraTable.SetSpinner(&jiraSpinner)
 
+	commitsCtx, commitsCancel := context.WithCancel(context.Background())
+	jiraCtx, jiraCancel := context.WithCancel(context.Background())
+
 	return Model{
 		commitsTable:        commitsTable,
 		jiraTable:           jiraTable,
@@ -106,6 +136,7 @@ func InitialModel(selectedFolder string, selectedDate time.Time, hasGit, hasJira
 		selectedRepoSources: repoSources,
 		selectedJiraSource:  jiraSource,
 		selectedDate:        selectedDate,
+		selectedUntil:       selectedUntil,
 		hasGit:              actualHasGit,
 		hasJira:             actualHasJira,
 		noConfigMessage:     noConfigMessage,
@@ -114,6 +145,13 @@ func InitialModel(selectedFolder string, selectedDate time.Time, hasGit, hasJira
 		jiraSpinner:         jiraSpinner,
 		commitsLoading:      actualHasGit,
 		jiraLoading:         actualHasJira,
+		retryingSources:     make(map[string]bool),
+		showStats:           showStats,
+		matchCoAuthors:      matchCoAuthors,
+		commitsCtx:          commitsCtx,
+		commitsCancel:       commitsCancel,
+		jiraCtx:             jiraCtx,
+		jiraCancel:          jiraCancel,
 	}
 }
 
@@ -123,34 +161,60 @@ func (m Model) Init() tea.Cmd {
 	cmds = append(cmds, m.commandHandler.Init())
 
 	if m.hasGit {
-		if len(m.selectedRepoSources) > 1 {
-			cmds = append(cmds, m.commitsTable.Init(), m.commitsSpinner.Tick, loadCommitsFromMultipleReposCmd(m.selectedRepoSources, m.selectedDate))
-		} else if len(m.selectedRepoSources) == 1 {
-			cmds = append(cmds, m.commitsTable.Init(), m.commitsSpinner.Tick, loadCommitsCmd(m.selectedRepoSources[0], m.selectedDate))
+		if len(m.selectedRepoSources) > 0 {
+			cmds = append(cmds, m.commitsTable.Init(), m.commitsSpinner.Tick, loadCommitsCmd(m.commitsCtx, m.commitsGeneration, m.selectedRepoSources, m.commitQuery()))
 		}
 	}
 
 	if m.hasJira {
-		cmds = append(cmds, m.jiraTable.Init(), m.jiraSpinner.Tick, loadJiraCmd(m.selectedJiraSource, m.selectedDate))
+		cmds = append(cmds, m.jiraTable.Init(), m.jiraSpinner.Tick, loadJiraCmd(m.jiraCtx, m.jiraGeneration, m.selectedJiraSource, m.selectedDate, m.selectedUntil))
 	}
 
 	return tea.Batch(cmds...)
 }
 
+type failedSource struct {
+	kind   string
+	source string
+	err    string
+}
+
 type LoadedMsg struct {
+	Generation   int
 	CommitsTable commitstable.Model
 }
 
 type LoadErrorMsg struct {
-	Error string
+	Generation int
+	Error      string
+}
+
+type RetryLoadedMsg struct {
+	Generation int
+	Source     string
+	Result     *git.CommitResult
+}
+
+type CommitFilesLoadedMsg struct {
+	Generation int
+	Hash       string
+	Files      []git.FileChange
+	Err        error
+}
+
+type CommitStatsLoadedMsg struct {
+	Generation int
+	Results    []git.CommitFilesResult
 }
 
 type JiraLoadedMsg struct {
-	JiraTable jiratable.Model
+	Generation int
+	JiraTable  jiratable.Model
 }
 
 type JiraLoadErrorMsg struct {
-	Error string
+	Generation int
+	Error      string
 }
 
 func (m Model) GetCommand() string {
@@ -166,3 +230,12 @@ func (m *Model) ResetCommand() {
 	m.command = ""
 	m.commandHandler.ClearMessages()
 }
+
+func (m *Model) CancelLoads() {
+	if m.commitsCancel != nil {
+		m.commitsCancel()
+	}
+	if m.jiraCancel != nil {
+		m.jiraCancel()
+	}
+}
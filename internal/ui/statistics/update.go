@@ -1,8 +1,12 @@
 package statistics
 
 import (
+	"context"
+	"fmt"
 	"project-void/internal/commands"
 	"project-void/internal/config"
+	"project-void/internal/git"
+	"project-void/internal/helpers"
 	"project-void/internal/ui/common"
 	commitstable "project-void/internal/ui/statistics/commits-table"
 	jiratable "project-void/internal/ui/statistics/jira-table"
@@ -19,6 +23,154 @@ func (m Model) getFirstRepoSource() string {
 	return ""
 }
 
+func (m Model) commitQuery() git.CommitQuery {
+	query := git.CommitQuery{
+		Since:    m.selectedDate,
+		Authors:  m.authorFilter,
+		Branches: m.branchFilter,
+		Stats:    m.showStats,
+		Merges:   m.mergeFilter,
+		Paths:    m.pathFilter,
+		Types:    m.typeFilter,
+
+		Message:              m.messageFilter,
+		MessageCaseSensitive: m.messageCaseSensitive,
+
+		MatchCoAuthors: m.matchCoAuthors,
+	}
+	if !m.selectedUntil.IsZero() {
+		query.Until = helpers.ToEndOfDay(m.selectedUntil)
+	}
+	return query
+}
+
+func (m *Model) startCommitsLoad() tea.Cmd {
+	if m.commitsCancel != nil {
+		m.commitsCancel()
+	}
+	m.commitsCtx, m.commitsCancel = context.WithCancel(context.Background())
+	m.commitsGeneration++
+	m.retryingSources = make(map[string]bool)
+	return loadCommitsCmd(m.commitsCtx, m.commitsGeneration, m.selectedRepoSources, m.commitQuery())
+}
+
+func (m *Model) reloadCommits() tea.Cmd {
+	m.commitsLoading = true
+	tickCmd := m.commitsTable.StartLoadingWithCmd()
+	return tea.Batch(tickCmd, m.startCommitsLoad(), m.commitsSpinner.Tick)
+}
+
+func (m Model) setCommitsFilter(cmd tea.Cmd, filterName string, apply func(m *Model)) (Model, tea.Cmd) {
+	if !m.hasGit || len(m.selectedRepoSources) == 0 {
+		m.commandHandler.SetError(fmt.Sprintf("%s filtering only available in development mode with a repository selected", filterName))
+		return m, cmd
+	}
+
+	apply(&m)
+	loadCmd := m.reloadCommits()
+	return m, loadCmd
+}
+
+func (m Model) hasCommitsFilter() bool {
+	return len(m.authorFilter) > 0 || len(m.branchFilter) > 0 || m.mergeFilter != "" || len(m.pathFilter) > 0 || m.messageFilter != "" || len(m.typeFilter) > 0
+}
+
+func (m *Model) clearCommitsFilters() {
+	m.authorFilter = nil
+	m.branchFilter = nil
+	m.mergeFilter = ""
+	m.pathFilter = nil
+	m.messageFilter = ""
+	m.messageCaseSensitive = false
+	m.typeFilter = nil
+}
+
+func (m *Model) startJiraLoad() tea.Cmd {
+	if m.jiraCancel != nil {
+		m.jiraCancel()
+	}
+	m.jiraCtx, m.jiraCancel = context.WithCancel(context.Background())
+	m.jiraGeneration++
+	return loadJiraCmd(m.jiraCtx, m.jiraGeneration, m.selectedJiraSource, m.selectedDate, m.selectedUntil)
+}
+
+func (m *Model) loadSelectedCommitFiles() tea.Cmd {
+	if !m.commitsTable.IsShowingDetail() {
+		return nil
+	}
+
+	commit, ok := m.commitsTable.SelectedCommit()
+	if !ok || !m.commitsTable.NeedsFiles(commit.Hash) {
+		return nil
+	}
+
+	ctx := m.commitsCtx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	m.commitsTable.SetFilesLoading(commit.Hash)
+	return loadCommitFilesCmd(ctx, m.commitsGeneration, commit.Source, commit.Hash)
+}
+
+func (m *Model) loadVisibleCommitStats() tea.Cmd {
+	commits := m.commitsTable.StatsToLoad()
+	if len(commits) == 0 {
+		return nil
+	}
+
+	ctx := m.commitsCtx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return loadCommitStatsCmd(ctx, m.commitsGeneration, commits)
+}
+
+func (m Model) failedSources() []failedSource {
+	var failed []failedSource
+
+	if !m.commitsLoading {
+		if m.loadError != "" {
+			failed = append(failed, failedSource{kind: "git", source: "Git repositories", err: m.loadError})
+		}
+		for _, failure := range m.commitsTable.Failures() {
+			errText := failure.Err.Error()
+			if m.retryingSources[failure.Source] {
+				errText = "retrying..."
+			}
+			failed = append(failed, failedSource{kind: "repo", source: failure.Source, err: errText})
+		}
+	}
+
+	if !m.jiraLoading && m.jiraError != "" {
+		failed = append(failed, failedSource{kind: "jira", source: fmt.Sprintf("JIRA %s", m.selectedJiraSource), err: m.jiraError})
+	}
+
+	return failed
+}
+
+func (m Model) retrySource(failed failedSource) (Model, tea.Cmd) {
+	switch failed.kind {
+	case "repo":
+		if m.retryingSources[failed.source] {
+			return m, nil
+		}
+		m.retryingSources[failed.source] = true
+		return m, retryCommitsCmd(m.commitsCtx, m.commitsGeneration, failed.source, m.commitQuery())
+	case "git":
+		m.loadError = ""
+		loadCmd := m.reloadCommits()
+		return m, loadCmd
+	case "jira":
+		m.jiraError = ""
+		m.jiraLoading = true
+		tickCmd := m.jiraTable.StartLoadingWithCmd()
+		return m, tea.Batch(tickCmd, m.startJiraLoad(), m.jiraSpinner.Tick)
+	}
+	return m, nil
+}
+
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
@@ -120,6 +272,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 			if result != nil {
 				if result.ShouldQuit {
+					m.CancelLoads()
 					return m, tea.Quit
 				}
 
@@ -136,147 +289,114 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 							m.commandHandler.SetError("Invalid author names in command")
 							return m, cmd
 						}
+						return m.setCommitsFilter(cmd, "Author", func(m *Model) { m.authorFilter = authorNames })
+					}
+				}
 
-						if m.hasGit && len(m.selectedRepoSources) > 0 {
-							tickCmd := m.commitsTable.StartLoadingWithCmd()
-							m.authorFilter = authorNames
-							m.commitsLoading = true
+				if result.Action == "clear_author_filter" {
+					return m.setCommitsFilter(cmd, "Author", func(m *Model) { m.authorFilter = nil })
+				}
 
-							var loadCmd tea.Cmd
-							if len(m.branchFilter) > 0 {
-								if len(m.selectedRepoSources) > 1 {
-									loadCmd = loadCommitsByAuthorsAndBranchesFromMultipleReposCmd(m.selectedRepoSources, m.selectedDate, authorNames, m.branchFilter)
-								} else {
-									loadCmd = loadCommitsByAuthorsAndBranchesCmd(m.selectedRepoSources[0], m.selectedDate, authorNames, m.branchFilter)
-								}
-							} else {
-								if len(m.selectedRepoSources) > 1 {
-									loadCmd = loadCommitsByAuthorsFromMultipleReposCmd(m.selectedRepoSources, m.selectedDate, authorNames)
-								} else {
-									loadCmd = loadCommitsByAuthorsCmd(m.selectedRepoSources[0], m.selectedDate, authorNames)
-								}
-							}
-							return m, tea.Batch(tickCmd, loadCmd, m.commitsSpinner.Tick)
-						} else {
-							m.commandHandler.SetError("Author filtering only available in development mode with a repository selected")
+				if result.Action == "filter_by_branch" && result.Data != nil {
+					if commandData, ok := result.Data["command"].(commands.Command); ok {
+						branchNames := commands.GetBranchNamesFromCommand(commandData.Name)
+						if len(branchNames) == 0 {
+							m.commandHandler.SetError("Invalid branch names in command")
 							return m, cmd
 						}
+						return m.setCommitsFilter(cmd, "Branch", func(m *Model) { m.branchFilter = branchNames })
 					}
 				}
 
-				if result.Action == "clear_author_filter" {
-					if m.hasGit && len(m.selectedRepoSources) > 0 {
-						m.authorFilter = nil
-						m.commitsLoading = true
-						tickCmd := m.commitsTable.StartLoadingWithCmd()
-
-						var loadCmd tea.Cmd
-						if len(m.branchFilter) > 0 {
-							if len(m.selectedRepoSources) > 1 {
-								loadCmd = loadCommitsByBranchesFromMultipleReposCmd(m.selectedRepoSources, m.selectedDate, m.branchFilter)
-							} else {
-								loadCmd = loadCommitsByBranchesCmd(m.selectedRepoSources[0], m.selectedDate, m.branchFilter)
-							}
-						} else {
-							if len(m.selectedRepoSources) > 1 {
-								loadCmd = loadCommitsFromMultipleReposCmd(m.selectedRepoSources, m.selectedDate)
-							} else {
-								loadCmd = loadCommitsCmd(m.selectedRepoSources[0], m.selectedDate)
-							}
+				if result.Action == "clear_branch_filter" {
+					return m.setCommitsFilter(cmd, "Branch", func(m *Model) { m.branchFilter = nil })
+				}
+
+				if result.Action == "filter_by_message" && result.Data != nil {
+					if commandData, ok := result.Data["command"].(commands.Command); ok {
+						pattern, caseSensitive := commands.GetMessageFilterFromCommand(commandData.Name)
+						if pattern == "" {
+							m.commandHandler.SetError("Invalid message search in command")
+							return m, cmd
 						}
-						return m, tea.Batch(tickCmd, loadCmd, m.commitsSpinner.Tick)
-					} else {
-						m.commandHandler.SetError("Author filtering only available in development mode with a repository selected")
-						return m, cmd
+						return m.setCommitsFilter(cmd, "Message", func(m *Model) {
+							m.messageFilter = pattern
+							m.messageCaseSensitive = caseSensitive
+						})
 					}
 				}
 
-				if result.Action == "filter_by_branch" && result.Data != nil {
+				if result.Action == "clear_message_filter" {
+					return m.setCommitsFilter(cmd, "Message", func(m *Model) {
+						m.messageFilter = ""
+						m.messageCaseSensitive = false
+					})
+				}
+
+				if result.Action == "filter_by_type" && result.Data != nil {
 					if commandData, ok := result.Data["command"].(commands.Command); ok {
-						branchNames := commands.GetBranchNamesFromCommand(commandData.Name)
-						if len(branchNames) == 0 {
-							m.commandHandler.SetError("Invalid branch names in command")
+						types := commands.GetTypesFromCommand(commandData.Name)
+						if len(types) == 0 {
+							m.commandHandler.SetError("Invalid commit types in command")
 							return m, cmd
 						}
+						return m.setCommitsFilter(cmd, "Type", func(m *Model) { m.typeFilter = types })
+					}
+				}
 
-						if m.hasGit && len(m.selectedRepoSources) > 0 {
-							tickCmd := m.commitsTable.StartLoadingWithCmd()
-							m.branchFilter = branchNames
-							m.commitsLoading = true
+				if result.Action == "clear_type_filter" {
+					return m.setCommitsFilter(cmd, "Type", func(m *Model) { m.typeFilter = nil })
+				}
 
-							var loadCmd tea.Cmd
-							if len(m.authorFilter) > 0 {
-								if len(m.selectedRepoSources) > 1 {
-									loadCmd = loadCommitsByAuthorsAndBranchesFromMultipleReposCmd(m.selectedRepoSources, m.selectedDate, m.authorFilter, branchNames)
-								} else {
-									loadCmd = loadCommitsByAuthorsAndBranchesCmd(m.selectedRepoSources[0], m.selectedDate, m.authorFilter, branchNames)
-								}
-							} else {
-								if len(m.selectedRepoSources) > 1 {
-									loadCmd = loadCommitsByBranchesFromMultipleReposCmd(m.selectedRepoSources, m.selectedDate, branchNames)
-								} else {
-									loadCmd = loadCommitsByBranchesCmd(m.selectedRepoSources[0], m.selectedDate, branchNames)
-								}
-							}
-							return m, tea.Batch(tickCmd, loadCmd, m.commitsSpinner.Tick)
-						} else {
-							m.commandHandler.SetError("Branch filtering only available in development mode with a repository selected")
+				if result.Action == "filter_by_path" && result.Data != nil {
+					if commandData, ok := result.Data["command"].(commands.Command); ok {
+						paths := commands.GetPathsFromCommand(commandData.Name)
+						if len(paths) == 0 {
+							m.commandHandler.SetError("Invalid paths in command")
 							return m, cmd
 						}
+						return m.setCommitsFilter(cmd, "Path", func(m *Model) { m.pathFilter = paths })
 					}
 				}
 
-				if result.Action == "clear_branch_filter" {
-					if m.hasGit && len(m.selectedRepoSources) > 0 {
-						m.branchFilter = nil
-						m.commitsLoading = true
-						tickCmd := m.commitsTable.StartLoadingWithCmd()
-
-						var loadCmd tea.Cmd
-						if len(m.authorFilter) > 0 {
-							if len(m.selectedRepoSources) > 1 {
-								loadCmd = loadCommitsByAuthorsFromMultipleReposCmd(m.selectedRepoSources, m.selectedDate, m.authorFilter)
-							} else {
-								loadCmd = loadCommitsByAuthorsCmd(m.selectedRepoSources[0], m.selectedDate, m.authorFilter)
-							}
-						} else {
-							if len(m.selectedRepoSources) > 1 {
-								loadCmd = loadCommitsFromMultipleReposCmd(m.selectedRepoSources, m.selectedDate)
-							} else {
-								loadCmd = loadCommitsCmd(m.selectedRepoSources[0], m.selectedDate)
-							}
+				if result.Action == "clear_path_filter" {
+					return m.setCommitsFilter(cmd, "Path", func(m *Model) { m.pathFilter = nil })
+				}
+
+				if result.Action == "filter_by_merges" && result.Data != nil {
+					if commandData, ok := result.Data["command"].(commands.Command); ok {
+						mergeMode := commands.GetMergeModeFromCommand(commandData.Name)
+						if mergeMode == "" {
+							m.commandHandler.SetError("Invalid merges mode in command")
+							return m, cmd
+						}
+						if mergeMode == "on" {
+							mergeMode = ""
 						}
-						return m, tea.Batch(tickCmd, loadCmd, m.commitsSpinner.Tick)
-					} else {
-						m.commandHandler.SetError("Branch filtering only available in development mode with a repository selected")
-						return m, cmd
+						return m.setCommitsFilter(cmd, "Merge", func(m *Model) { m.mergeFilter = mergeMode })
 					}
 				}
 
 				if result.Action == "start" || result.Action == "reset" {
-					if m.hasGit && len(m.selectedRepoSources) > 0 && (len(m.authorFilter) > 0 || len(m.branchFilter) > 0) {
-						m.authorFilter = nil
-						m.branchFilter = nil
-						m.commitsLoading = true
-						tickCmd := m.commitsTable.StartLoadingWithCmd()
-						var loadCmd tea.Cmd
-						if len(m.selectedRepoSources) > 1 {
-							loadCmd = loadCommitsFromMultipleReposCmd(m.selectedRepoSources, m.selectedDate)
-						} else {
-							loadCmd = loadCommitsCmd(m.selectedRepoSources[0], m.selectedDate)
-						}
+					if m.hasGit && len(m.selectedRepoSources) > 0 && m.hasCommitsFilter() {
+						m.clearCommitsFilters()
 						m.command = result.Action
 						m.submitted = true
-						return m, tea.Batch(tickCmd, loadCmd, m.commitsSpinner.Tick)
+						loadCmd := m.reloadCommits()
+						return m, loadCmd
 					}
 					m.command = result.Action
 					m.submitted = true
 					return m, cmd
 				}
 
-				if result.Action == "void_set_date" {
+				if result.Action == "void_set_date" || result.Action == "void_set_range" {
 					if dateData, ok := result.Data["date"].(time.Time); ok {
 						m.selectedDate = dateData
+						m.selectedUntil = time.Time{}
+						if untilData, ok := result.Data["until"].(time.Time); ok {
+							m.selectedUntil = untilData
+						}
 						m.commandHandler.ClearMessages()
 
 						var cmds []tea.Cmd
@@ -286,39 +406,14 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 							tickCmd := m.commitsTable.StartLoadingWithCmd()
 							cmds = append(cmds, tickCmd, m.commitsSpinner.Tick)
 
-							var loadCmd tea.Cmd
-							if len(m.authorFilter) > 0 && len(m.branchFilter) > 0 {
-								if len(m.selectedRepoSources) > 1 {
-									loadCmd = loadCommitsByAuthorsAndBranchesFromMultipleReposCmd(m.selectedRepoSources, m.selectedDate, m.authorFilter, m.branchFilter)
-								} else {
-									loadCmd = loadCommitsByAuthorsAndBranchesCmd(m.selectedRepoSources[0], m.selectedDate, m.authorFilter, m.branchFilter)
-								}
-							} else if len(m.authorFilter) > 0 {
-								if len(m.selectedRepoSources) > 1 {
-									loadCmd = loadCommitsByAuthorsFromMultipleReposCmd(m.selectedRepoSources, m.selectedDate, m.authorFilter)
-								} else {
-									loadCmd = loadCommitsByAuthorsCmd(m.selectedRepoSources[0], m.selectedDate, m.authorFilter)
-								}
-							} else if len(m.branchFilter) > 0 {
-								if len(m.selectedRepoSources) > 1 {
-									loadCmd = loadCommitsByBranchesFromMultipleReposCmd(m.selectedRepoSources, m.selectedDate, m.branchFilter)
-								} else {
-									loadCmd = loadCommitsByBranchesCmd(m.selectedRepoSources[0], m.selectedDate, m.branchFilter)
-								}
-							} else {
-								if len(m.selectedRepoSources) > 1 {
-									loadCmd = loadCommitsFromMultipleReposCmd(m.selectedRepoSources, m.selectedDate)
-								} else {
-									loadCmd = loadCommitsCmd(m.selectedRepoSources[0], m.selectedDate)
-								}
-							}
+							loadCmd := m.startCommitsLoad()
 							cmds = append(cmds, loadCmd)
 						}
 
 						if m.hasJira && m.selectedJiraSource != "" {
 							m.jiraLoading = true
 							jiraTickCmd := m.jiraTable.StartLoadingWithCmd()
-							jiraLoadCmd := loadJiraCmd(m.selectedJiraSource, m.selectedDate)
+							jiraLoadCmd := m.startJiraLoad()
 							cmds = append(cmds, jiraTickCmd, jiraLoadCmd, m.jiraSpinner.Tick)
 						}
 
@@ -327,12 +422,43 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					return m, cmd
 				}
 
+				if result.Action == "git_stats_on" || result.Action == "git_stats_off" {
+					if result.Success {
+						m.showStats = result.Action == "git_stats_on"
+						m.commitsTable.SetShowStats(m.showStats)
+						if m.hasGit && len(m.selectedRepoSources) > 0 {
+							loadCmd := m.reloadCommits()
+							return m, loadCmd
+						}
+					}
+					return m, cmd
+				}
+
+				if result.Action == "git_coauthors_on" || result.Action == "git_coauthors_off" {
+					if result.Success {
+						m.matchCoAuthors = result.Action == "git_coauthors_on"
+						if m.hasGit && len(m.selectedRepoSources) > 0 && len(m.authorFilter) > 0 {
+							loadCmd := m.reloadCommits()
+							return m, loadCmd
+						}
+					}
+					return m, cmd
+				}
+
+				if result.Action == "git_set_alias" || result.Action == "git_remove_alias" {
+					if result.Success && m.hasGit && len(m.selectedRepoSources) > 0 {
+						loadCmd := m.reloadCommits()
+						return m, loadCmd
+					}
+					return m, cmd
+				}
+
 				if result.Action == "jira_filter_on" || result.Action == "jira_filter_off" {
 					if m.hasJira && m.selectedJiraSource != "" {
 						if result.Success {
 							m.jiraLoading = true
 							tickCmd := m.jiraTable.StartLoadingWithCmd()
-							jiraLoadCmd := loadJiraCmd(m.selectedJiraSource, m.selectedDate)
+							jiraLoadCmd := m.startJiraLoad()
 							return m, tea.Batch(tickCmd, jiraLoadCmd, m.jiraSpinner.Tick)
 						}
 					}
@@ -357,8 +483,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 							}
 							m.commandHandler = common.NewStatisticsCommandHandler("Enter a command (e.g., git repo <url>, git a <author>, void help)...", firstRepo, m.hasGit, m.hasJira)
 
-							m.authorFilter = nil
-							m.branchFilter = nil
+							m.clearCommitsFilters()
 
 							if m.hasGit && m.hasJira {
 								m.commitsTable.Focus()
@@ -373,15 +498,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 							}
 
 							if m.hasGit && len(m.selectedRepoSources) > 0 {
-								m.commitsLoading = true
-								tickCmd := m.commitsTable.StartLoadingWithCmd()
-								var loadCmd tea.Cmd
-								if len(m.selectedRepoSources) > 1 {
-									loadCmd = loadCommitsFromMultipleReposCmd(m.selectedRepoSources, m.selectedDate)
-								} else {
-									loadCmd = loadCommitsCmd(m.selectedRepoSources[0], m.selectedDate)
-								}
-								return m, tea.Batch(tickCmd, loadCmd, m.commitsSpinner.Tick)
+								loadCmd := m.reloadCommits()
+								return m, loadCmd
 							} else {
 								m.commitsTable = commitstable.InitialModel()
 								return m, cmd
@@ -419,7 +537,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 							if jiraConfig.Jira.BaseURL != "" && jiraConfig.Jira.Username != "" && jiraConfig.Jira.ApiToken != "" {
 								m.jiraLoading = true
 								tickCmd := m.jiraTable.StartLoadingWithCmd()
-								loadCmd := loadJiraCmd(m.selectedJiraSource, m.selectedDate)
+								loadCmd := m.startJiraLoad()
 								return m, tea.Batch(tickCmd, loadCmd, m.jiraSpinner.Tick)
 							}
 						} else {
@@ -481,13 +599,36 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		if failed := m.failedSources(); len(failed) > 0 {
+			if key == "r" {
+				var retryCmds []tea.Cmd
+				for _, source := range failed {
+					var cmd tea.Cmd
+					m, cmd = m.retrySource(source)
+					if cmd != nil {
+						retryCmds = append(retryCmds, cmd)
+					}
+				}
+				return m, tea.Batch(retryCmds...)
+			}
+
+			if len(key) == 1 && key[0] >= '1' && key[0] <= '9' {
+				index := int(key[0] - '1')
+				if index < len(failed) {
+					return m.retrySource(failed[index])
+				}
+			}
+		}
+
 		rowKeys := map[string]bool{"up": true, "down": true, "k": true, "j": true, "pgup": true, "pgdown": true, "home": true, "end": true}
 		if rowKeys[key] {
 			if m.hasGit {
 				if m.focusedTable == 0 {
 					updated, cmd := m.commitsTable.Update(msg)
 					m.commitsTable = updated.(commitstable.Model)
-					return m, cmd
+					filesCmd := m.loadSelectedCommitFiles()
+					statsCmd := m.loadVisibleCommitStats()
+					return m, tea.Batch(cmd, filesCmd, statsCmd)
 				} else if m.focusedTable == 1 {
 					updated, cmd := m.jiraTable.Update(msg)
 					m.jiraTable = updated.(jiratable.Model)
@@ -502,7 +643,19 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
+		if key == "enter" && m.hasGit && m.focusedTable == 0 {
+			m.commitsTable.ToggleDetail()
+			filesCmd := m.loadSelectedCommitFiles()
+			return m, filesCmd
+		}
+
+		if key == "esc" && m.commitsTable.IsShowingDetail() {
+			m.commitsTable.HideDetail()
+			return m, nil
+		}
+
 		if key == "ctrl+c" || key == "esc" {
+			m.CancelLoads()
 			return m, tea.Quit
 		}
 
@@ -520,9 +673,15 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, tea.Batch(cmds...)
 
 	case LoadedMsg:
+		if msg.Generation != m.commitsGeneration {
+			return m, nil
+		}
 		m.loaded = true
 		m.commitsLoading = false
+		m.loadError = ""
+		m.retryingSources = make(map[string]bool)
 		m.commitsTable = msg.CommitsTable
+		m.githubRateLimit = m.currentGitHubRateLimit()
 		updatedCommits, cmd := m.commitsTable.Update(commitstable.LoadingCompleteMsg{})
 		m.commitsTable = updatedCommits.(commitstable.Model)
 
@@ -537,15 +696,54 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if cmd != nil {
 			cmds = append(cmds, cmd)
 		}
+		if statsCmd := m.loadVisibleCommitStats(); statsCmd != nil {
+			cmds = append(cmds, statsCmd)
+		}
 		return m, tea.Batch(cmds...)
 
 	case LoadErrorMsg:
+		if msg.Generation != m.commitsGeneration {
+			return m, nil
+		}
 		m.loaded = true
+		m.commitsLoading = false
 		m.loadError = msg.Error
+		m.commitsTable.SetLoadError(msg.Error)
+		m.githubRateLimit = m.currentGitHubRateLimit()
 		return m, nil
 
+	case CommitFilesLoadedMsg:
+		if msg.Generation != m.commitsGeneration {
+			return m, nil
+		}
+		m.commitsTable.SetFiles(msg.Hash, msg.Files, msg.Err)
+		return m, nil
+
+	case CommitStatsLoadedMsg:
+		if msg.Generation != m.commitsGeneration {
+			return m, nil
+		}
+		for _, result := range msg.Results {
+			m.commitsTable.SetFiles(result.Hash, result.Files, result.Err)
+		}
+		return m, nil
+
+	case RetryLoadedMsg:
+		if msg.Generation != m.commitsGeneration || !m.retryingSources[msg.Source] {
+			return m, nil
+		}
+		delete(m.retryingSources, msg.Source)
+		m.commitsTable.MergeRetry(msg.Source, msg.Result)
+		m.githubRateLimit = m.currentGitHubRateLimit()
+		statsCmd := m.loadVisibleCommitStats()
+		return m, statsCmd
+
 	case JiraLoadedMsg:
+		if msg.Generation != m.jiraGeneration {
+			return m, nil
+		}
 		m.jiraLoading = false
+		m.jiraError = ""
 		m.jiraTable = msg.JiraTable
 		updatedJira, cmd := m.jiraTable.Update(jiratable.LoadingCompleteMsg{})
 		m.jiraTable = updatedJira.(jiratable.Model)
@@ -564,6 +762,12 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, tea.Batch(cmds...)
 
 	case JiraLoadErrorMsg:
+		if msg.Generation != m.jiraGeneration {
+			return m, nil
+		}
+		m.jiraLoading = false
+		m.jiraError = msg.Error
+		m.jiraTable.SetLoadError(msg.Error)
 		return m, nil
 
 	case spinner.TickMsg:
@@ -2,6 +2,7 @@ package statistics
 
 import (
 	"fmt"
+	"project-void/internal/git"
 	"project-void/internal/ui/styles"
 	"strings"
 
@@ -28,7 +29,7 @@ func (m Model) View() string {
 	if commandInput := m.commandHandler.RenderCommandInput(contentWidth); commandInput != "" {
 		commandHeader = commandInput
 	} else {
-		navHelp := "\nw/s: navigate tables • c: commands • esc: exit"
+		navHelp := "\nw/s: navigate tables • enter: commit details • c: commands • esc: exit"
 
 		if m.commandHandler.HasSuccessMessage() {
 			successText := m.commandHandler.GetSuccessMessage()
@@ -66,19 +67,7 @@ func (m Model) View() string {
 			}
 			jiraHeader := fmt.Sprintf("JIRA Issues for %s", m.selectedJiraSource)
 
-			if len(m.authorFilter) > 0 {
-				authorFilterText := strings.Join(m.authorFilter, ", ")
-				commitsHeader += fmt.Sprintf(" (filtered by authors: %s)", authorFilterText)
-			}
-
-			if len(m.branchFilter) > 0 {
-				branchFilterText := strings.Join(m.branchFilter, ", ")
-				if len(m.authorFilter) > 0 {
-					commitsHeader += fmt.Sprintf(" and branches: %s", branchFilterText)
-				} else {
-					commitsHeader += fmt.Sprintf(" (filtered by branches: %s)", branchFilterText)
-				}
-			}
+			commitsHeader += m.commitsFilterText()
 
 			commitsHeader = styles.WelcomeStyle.Render(commitsHeader)
 			jiraHeader = styles.WelcomeStyle.Render(jiraHeader)
@@ -91,6 +80,9 @@ func (m Model) View() string {
 			} else {
 				totalCommits := m.commitsTable.TotalCommits()
 				commitsText = fmt.Sprintf("%d commits", totalCommits)
+				if statsSummary := m.commitsTable.StatsSummary(); statsSummary != "" {
+					commitsText += fmt.Sprintf(" (%s)", statsSummary)
+				}
 			}
 
 			var jiraText string
@@ -101,8 +93,14 @@ func (m Model) View() string {
 				jiraText = fmt.Sprintf("%d JIRA issues", totalIssues)
 			}
 
-			dateInfo := fmt.Sprintf("%s, %s since %s", commitsText, jiraText, m.selectedDate.Format("January 2, 2006"))
+			dateInfo := fmt.Sprintf("%s, %s %s", commitsText, jiraText, m.dateRangeText())
 			contentParts = append(contentParts, styles.NeutralStyle.Render(dateInfo))
+			if typeSummary := m.commitsTable.TypeSummary(); typeSummary != "" && !m.commitsLoading {
+				contentParts = append(contentParts, styles.NeutralStyle.Render(typeSummary))
+			}
+			contentParts = append(contentParts, m.renderTruncationWarning()...)
+			contentParts = append(contentParts, m.renderRateLimit()...)
+			contentParts = append(contentParts, m.renderFailedSources()...)
 
 			contentParts = append(contentParts, m.commitsTable.View())
 
@@ -118,19 +116,7 @@ func (m Model) View() string {
 				commitsHeader = "Commits"
 			}
 
-			if len(m.authorFilter) > 0 {
-				authorFilterText := strings.Join(m.authorFilter, ", ")
-				commitsHeader += fmt.Sprintf(" (filtered by authors: %s)", authorFilterText)
-			}
-
-			if len(m.branchFilter) > 0 {
-				branchFilterText := strings.Join(m.branchFilter, ", ")
-				if len(m.authorFilter) > 0 {
-					commitsHeader += fmt.Sprintf(" and branches: %s", branchFilterText)
-				} else {
-					commitsHeader += fmt.Sprintf(" (filtered by branches: %s)", branchFilterText)
-				}
-			}
+			commitsHeader += m.commitsFilterText()
 
 			header := styles.WelcomeStyle.Render(commitsHeader)
 			contentParts = append(contentParts, header)
@@ -141,10 +127,19 @@ func (m Model) View() string {
 			} else {
 				totalCommits := m.commitsTable.TotalCommits()
 				commitsText = fmt.Sprintf("%d commits", totalCommits)
+				if statsSummary := m.commitsTable.StatsSummary(); statsSummary != "" {
+					commitsText += fmt.Sprintf(" (%s)", statsSummary)
+				}
 			}
 
-			dateInfo := fmt.Sprintf("%s since %s", commitsText, m.selectedDate.Format("January 2, 2006"))
+			dateInfo := fmt.Sprintf("%s %s", commitsText, m.dateRangeText())
 			contentParts = append(contentParts, styles.NeutralStyle.Render(dateInfo))
+			if typeSummary := m.commitsTable.TypeSummary(); typeSummary != "" && !m.commitsLoading {
+				contentParts = append(contentParts, styles.NeutralStyle.Render(typeSummary))
+			}
+			contentParts = append(contentParts, m.renderTruncationWarning()...)
+			contentParts = append(contentParts, m.renderRateLimit()...)
+			contentParts = append(contentParts, m.renderFailedSources()...)
 
 			contentParts = append(contentParts, m.commitsTable.View())
 
@@ -161,8 +156,9 @@ func (m Model) View() string {
 				jiraText = fmt.Sprintf("%d JIRA issues", totalIssues)
 			}
 
-			dateInfo := fmt.Sprintf("%s since %s", jiraText, m.selectedDate.Format("January 2, 2006"))
+			dateInfo := fmt.Sprintf("%s %s", jiraText, m.dateRangeText())
 			contentParts = append(contentParts, styles.NeutralStyle.Render(dateInfo))
+			contentParts = append(contentParts, m.renderFailedSources()...)
 
 			contentParts = append(contentParts, m.jiraTable.View())
 		}
@@ -180,3 +176,125 @@ func (m Model) View() string {
 
 	return centerStyle.Render(fullContent)
 }
+
+func (m Model) dateRangeText() string {
+	if m.selectedUntil.IsZero() {
+		return fmt.Sprintf("since %s", m.selectedDate.Format("January 2, 2006"))
+	}
+	return fmt.Sprintf("from %s to %s", m.selectedDate.Format("January 2, 2006"), m.selectedUntil.Format("January 2, 2006"))
+}
+
+func (m Model) commitsFilterText() string {
+	var filters []string
+	if len(m.authorFilter) > 0 {
+		filters = append(filters, "authors: "+strings.Join(m.authorFilter, ", "))
+	}
+	if len(m.branchFilter) > 0 {
+		filters = append(filters, "branches: "+strings.Join(m.branchFilter, ", "))
+	}
+	if mergeFilterText := m.mergeFilterText(); mergeFilterText != "" {
+		filters = append(filters, mergeFilterText)
+	}
+	if len(m.pathFilter) > 0 {
+		filters = append(filters, "paths: "+strings.Join(m.pathFilter, ", "))
+	}
+	if messageFilterText := m.messageFilterText(); messageFilterText != "" {
+		filters = append(filters, "message: "+messageFilterText)
+	}
+	if len(m.typeFilter) > 0 {
+		filters = append(filters, "types: "+strings.Join(m.typeFilter, ", "))
+	}
+
+	if len(filters) == 0 {
+		return ""
+	}
+	if len(filters) == 1 && m.mergeFilter != "" {
+		return fmt.Sprintf(" (%s)", filters[0])
+	}
+	return fmt.Sprintf(" (filtered by %s)", strings.Join(filters, " and "))
+}
+
+func (m Model) messageFilterText() string {
+	if m.messageFilter == "" {
+		return ""
+	}
+
+	text := fmt.Sprintf("%q", m.messageFilter)
+	if m.messageCaseSensitive {
+		text += " (case-sensitive)"
+	}
+	return text
+}
+
+func (m Model) mergeFilterText() string {
+	switch m.mergeFilter {
+	case "off":
+		return "merge commits excluded"
+	case "only":
+		return "merge commits only"
+	}
+	return ""
+}
+
+func (m Model) renderTruncationWarning() []string {
+	warning := m.commitsTable.TruncationWarning()
+	if warning == "" || m.commitsLoading {
+		return nil
+	}
+
+	warningText := fmt.Sprintf("⚠ Commit history incomplete: %s. Use 'git pages <n>' to fetch more.", warning)
+	return []string{lipgloss.NewStyle().Foreground(lipgloss.Color("214")).Render(warningText)}
+}
+
+func (m Model) currentGitHubRateLimit() git.RateLimitStatus {
+	if !git.HasGitHubRepos(m.selectedRepoSources) {
+		return git.RateLimitStatus{}
+	}
+	return git.GitHubRateLimit()
+}
+
+func (m Model) renderRateLimit() []string {
+	if !m.githubRateLimit.Known || m.commitsLoading {
+		return nil
+	}
+
+	color := "8"
+	if m.githubRateLimit.Remaining == 0 {
+		color = "196"
+	} else if m.githubRateLimit.Limit > 0 && m.githubRateLimit.Remaining*10 < m.githubRateLimit.Limit {
+		color = "214"
+	}
+
+	rateLimitText := fmt.Sprintf("GitHub API: %s", m.githubRateLimit)
+	return []string{lipgloss.NewStyle().Foreground(lipgloss.Color(color)).Render(rateLimitText)}
+}
+
+func (m Model) renderFailedSources() []string {
+	failed := m.failedSources()
+	if len(failed) == 0 {
+		return nil
+	}
+
+	var lines []string
+	lines = append(lines, "⚠ Failed sources:")
+	for i, source := range failed {
+		errText := strings.ReplaceAll(source.err, "\n", " ")
+		if len(errText) > 100 {
+			errText = errText[:97] + "..."
+		}
+		if i < 9 {
+			lines = append(lines, fmt.Sprintf("  [%d] %s: %s", i+1, source.source, errText))
+		} else {
+			lines = append(lines, fmt.Sprintf("      %s: %s", source.source, errText))
+		}
+	}
+
+	hint := "Press 1-9 to retry a source, r to retry all"
+	if len(failed) == 1 {
+		hint = "Press 1 or r to retry"
+	}
+
+	panel := lipgloss.NewStyle().Foreground(lipgloss.Color("196")).Render(strings.Join(lines, "\n"))
+	hintStyled := lipgloss.NewStyle().Foreground(lipgloss.Color("8")).Render(hint)
+	return []string{panel, hintStyled}
+}
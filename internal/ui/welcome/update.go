@@ -30,6 +30,17 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if result.Success && result.Action == "void_set_date" {
 			if dateData, ok := result.Data["date"].(time.Time); ok {
 				m.selectedDate = &dateData
+				m.selectedUntil = nil
+			}
+			return m, cmd
+		}
+
+		if result.Success && result.Action == "void_set_range" {
+			dateData, okDate := result.Data["date"].(time.Time)
+			untilData, okUntil := result.Data["until"].(time.Time)
+			if okDate && okUntil {
+				m.selectedDate = &dateData
+				m.selectedUntil = &untilData
 			}
 			return m, cmd
 		}
@@ -14,6 +14,7 @@ type Model struct {
 	command        string
 	submitted      bool
 	selectedDate   *time.Time
+	selectedUntil  *time.Time
 }
 
 func InitialModel() Model {
@@ -45,3 +46,7 @@ func (m *Model) ResetCommand() {
 func (m Model) GetSelectedDate() *time.Time {
 	return m.selectedDate
 }
+
+func (m Model) GetSelectedUntil() *time.Time {
+	return m.selectedUntil
+}